@@ -1,25 +1,128 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	_ "embed"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strconv"
+
+	"github.com/goccy/go-yaml"
 
 	"github.com/ebisaan/inventory/internal/adapter/postgres"
 )
 
+//go:embed seed.yaml
+var defaultSeed []byte
+
+const usage = `Usage: automigrate -dsn <dsn> [command]
+
+Commands:
+  up        apply every pending migration (default)
+  down [N]  revert the N most recent migrations, 1 by default
+  status    list the migrations and whether they are applied
+  goto N    migrate up or down to version N, 0 reverts everything
+  seed [F]  upsert currencies and categories from the YAML or JSON file F,
+            the bundled seed.yaml by default
+`
+
 func main() {
 	dsn := flag.String("dsn", "", "Data connection string")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	db, err := postgres.NewAdapter(*dsn)
 	if err != nil {
-		log.Println("failed to create postgres adapter" + err.Error())
+		log.Fatalln("failed to create postgres adapter: " + err.Error())
 	}
 
-	log.Println("starting auto migrate on inventory database...")
-	err = db.AutoMigration(context.Background())
+	err = run(context.Background(), db, flag.Args())
 	if err != nil {
-		log.Println("failed to migrate" + err.Error())
+		log.Fatalln("failed to migrate: " + err.Error())
+	}
+}
+
+func run(ctx context.Context, db *postgres.Adapter, args []string) error {
+	cmd := "up"
+	if len(args) > 0 {
+		cmd, args = args[0], args[1:]
+	}
+
+	switch cmd {
+	case "up":
+		log.Println("applying pending migrations on inventory database...")
+		return db.MigrateUp(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[0])
+			}
+			steps = n
+		}
+
+		log.Printf("reverting %d migration(s) on inventory database...", steps)
+		return db.MigrateDown(ctx, steps)
+	case "goto":
+		if len(args) == 0 {
+			return fmt.Errorf("goto needs a version")
+		}
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+
+		log.Printf("migrating inventory database to version %d...", version)
+		return db.MigrateTo(ctx, version)
+	case "status":
+		statuses, err := db.MigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range statuses {
+			applied := "pending"
+			if s.Applied {
+				applied = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(os.Stdout, "%04d  %-32s %s\n", s.Version, s.Name, applied)
+		}
+
+		return nil
+	case "seed":
+		content := defaultSeed
+		if len(args) > 0 {
+			var err error
+			content, err = os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read seed file: %w", err)
+			}
+		}
+
+		var data postgres.SeedData
+		err := yaml.NewDecoder(bytes.NewReader(content), yaml.DisallowUnknownField()).Decode(&data)
+		if err != nil {
+			return fmt.Errorf("decode seed file: %w", err)
+		}
+
+		log.Println("seeding reference data on inventory database...")
+		result, err := db.Seed(ctx, &data)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("seeded %d currencies, %d main categories and %d subcategories",
+			result.Currencies, result.MainCategories, result.SubCategories)
+		return nil
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
 	}
 }
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	port "github.com/ebisaan/inventory/internal/application/port"
+)
+
+func runExport(ctx context.Context, app port.API, args []string) (err error) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "File format, csv or jsonl; guessed from the output file extension by default")
+	output := fs.String("o", "", "Output file; standard output by default")
+	fs.Parse(args)
+
+	if fs.NArg() != 0 {
+		return errors.New("export takes no arguments")
+	}
+
+	if *format == "" {
+		*format = formatFromExtension(*output)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("create export file: %w", err)
+		}
+		defer func() {
+			closeErr := f.Close()
+			if err == nil && closeErr != nil {
+				err = fmt.Errorf("close export file: %w", closeErr)
+			}
+		}()
+		w = f
+	}
+
+	bw := bufio.NewWriter(w)
+
+	var write func([]*domain.Product) error
+	var flush func() error
+	switch *format {
+	case formatCSV:
+		cw := csv.NewWriter(bw)
+		err := cw.Write(csvColumns)
+		if err != nil {
+			return fmt.Errorf("write csv header: %w", err)
+		}
+		write = func(products []*domain.Product) error {
+			for _, p := range products {
+				err := cw.Write(csvRow(exportRecord(p)))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case formatJSONL:
+		enc := json.NewEncoder(bw)
+		write = func(products []*domain.Product) error {
+			for _, p := range products {
+				err := enc.Encode(exportRecord(p))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		flush = func() error { return nil }
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	n := 0
+	err = app.ExportProducts(ctx, domain.Filter{}, func(products []*domain.Product) error {
+		n += len(products)
+		return write(products)
+	})
+	if err != nil {
+		return fmt.Errorf("export products: %w", err)
+	}
+
+	err = flush()
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	log.Printf("exported %d products", n)
+	return nil
+}
+
+func exportRecord(p *domain.Product) productRecord {
+	return productRecord{
+		ID:             p.ID,
+		SKU:            p.SKU,
+		Barcode:        p.Barcode,
+		Name:           p.Name,
+		MainCategory:   p.MainCategory,
+		SubCategory:    p.SubCategory,
+		StockNumber:    p.StockNumber,
+		Image:          p.Image,
+		DiscountPrice:  p.DiscountPrice,
+		ActualPrice:    p.ActualPrice,
+		CurrencyCode:   p.CurrencyCode,
+		CurrencySymbol: p.CurrencySymbol,
+		CreatedAt:      p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// csvRow lays out the record in the order of csvColumns.
+func csvRow(r productRecord) []string {
+	return []string{
+		strconv.FormatInt(r.ID, 10),
+		r.SKU,
+		r.Barcode,
+		r.Name,
+		r.MainCategory,
+		r.SubCategory,
+		strconv.Itoa(r.StockNumber),
+		r.Image,
+		strconv.FormatFloat(r.DiscountPrice, 'f', -1, 64),
+		strconv.FormatFloat(r.ActualPrice, 'f', -1, 64),
+		r.CurrencyCode,
+		r.CurrencySymbol,
+		r.CreatedAt,
+		r.UpdatedAt,
+	}
+}
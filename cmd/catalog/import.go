@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	port "github.com/ebisaan/inventory/internal/application/port"
+)
+
+const (
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+)
+
+// productRecord is a product in an import or export file. CSV files use the
+// json names as header. The fields an export adds beside those of
+// domain.CreateProductRequest are ignored on import, so that an export can
+// be imported again.
+type productRecord struct {
+	ID             int64   `json:"id,omitempty"`
+	SKU            string  `json:"sku,omitempty"`
+	Barcode        string  `json:"barcode,omitempty"`
+	Name           string  `json:"name"`
+	MainCategory   string  `json:"main_category,omitempty"`
+	SubCategory    string  `json:"sub_category"`
+	StockNumber    int     `json:"stock_number"`
+	Image          string  `json:"image"`
+	DiscountPrice  float64 `json:"discount_price"`
+	ActualPrice    float64 `json:"actual_price"`
+	CurrencyCode   string  `json:"currency_code"`
+	CurrencySymbol string  `json:"currency_symbol,omitempty"`
+	CreatedAt      string  `json:"created_at,omitempty"`
+	UpdatedAt      string  `json:"updated_at,omitempty"`
+}
+
+// parsedRecord is a record that could be read, with the line it starts on.
+type parsedRecord struct {
+	line int
+	req  *domain.CreateProductRequest
+}
+
+type parseError struct {
+	line int
+	err  error
+}
+
+func runImport(ctx context.Context, app port.API, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "File format, csv or jsonl; guessed from the file extension by default")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return errors.New("import needs exactly one file")
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = formatFromExtension(path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open import file: %w", err)
+	}
+	defer f.Close()
+
+	var records []parsedRecord
+	var parseErrs []parseError
+	switch *format {
+	case formatCSV:
+		records, parseErrs, err = readCSV(f)
+	case formatJSONL:
+		records, parseErrs, err = readJSONL(f)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	reqs := make([]*domain.CreateProductRequest, len(records))
+	for i, r := range records {
+		reqs[i] = r.req
+	}
+
+	report, err := app.ImportProducts(ctx, reqs)
+	if report != nil {
+		printReport(records, parseErrs, report)
+	}
+	if err != nil {
+		return fmt.Errorf("import products: %w", err)
+	}
+
+	if len(parseErrs) > 0 || len(report.Errors) > 0 {
+		return errors.New("some rows were not imported")
+	}
+
+	return nil
+}
+
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return formatCSV
+	default:
+		return formatJSONL
+	}
+}
+
+func readCSV(r io.Reader) ([]parsedRecord, []parseError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+		if !slices.Contains(csvColumns, header[i]) {
+			return nil, nil, fmt.Errorf("unknown csv column %q", header[i])
+		}
+	}
+
+	var records []parsedRecord
+	var parseErrs []parseError
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var csvErr *csv.ParseError
+			if errors.As(err, &csvErr) {
+				parseErrs = append(parseErrs, parseError{line: csvErr.StartLine, err: csvErr.Err})
+				continue
+			}
+			return nil, nil, fmt.Errorf("read csv: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+
+		req, err := csvRequest(header, row)
+		if err != nil {
+			parseErrs = append(parseErrs, parseError{line: line, err: err})
+			continue
+		}
+		records = append(records, parsedRecord{line: line, req: req})
+	}
+
+	return records, parseErrs, nil
+}
+
+var csvColumns = []string{
+	"id", "sku", "barcode", "name", "main_category", "sub_category", "stock_number", "image",
+	"discount_price", "actual_price", "currency_code", "currency_symbol",
+	"created_at", "updated_at",
+}
+
+func csvRequest(header, row []string) (*domain.CreateProductRequest, error) {
+	if len(row) != len(header) {
+		return nil, fmt.Errorf("got %d fields, want %d", len(row), len(header))
+	}
+
+	req := &domain.CreateProductRequest{}
+	for i, col := range header {
+		value := strings.TrimSpace(row[i])
+
+		var err error
+		switch col {
+		case "sku":
+			req.SKU = value
+		case "barcode":
+			req.Barcode = value
+		case "name":
+			req.Name = value
+		case "sub_category":
+			req.SubCategory = value
+		case "image":
+			req.Image = value
+		case "currency_code":
+			req.CurrencyCode = value
+		case "stock_number":
+			req.StockNumber, err = parseInt(value)
+		case "discount_price":
+			req.DiscountPrice, err = parseFloat(value)
+		case "actual_price":
+			req.ActualPrice, err = parseFloat(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", col, err)
+		}
+	}
+
+	return req, nil
+}
+
+func parseInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func parseFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func readJSONL(r io.Reader) ([]parsedRecord, []parseError, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var records []parsedRecord
+	var parseErrs []parseError
+	line := 0
+	for scanner.Scan() {
+		line++
+		content := bytes.TrimSpace(scanner.Bytes())
+		if len(content) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.DisallowUnknownFields()
+
+		var rec productRecord
+		err := dec.Decode(&rec)
+		if err != nil {
+			parseErrs = append(parseErrs, parseError{line: line, err: err})
+			continue
+		}
+
+		records = append(records, parsedRecord{line: line, req: &domain.CreateProductRequest{
+			SKU:           rec.SKU,
+			Barcode:       rec.Barcode,
+			Name:          rec.Name,
+			SubCategory:   rec.SubCategory,
+			StockNumber:   rec.StockNumber,
+			Image:         rec.Image,
+			DiscountPrice: rec.DiscountPrice,
+			ActualPrice:   rec.ActualPrice,
+			CurrencyCode:  rec.CurrencyCode,
+		}})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("read jsonl: %w", err)
+	}
+
+	return records, parseErrs, nil
+}
+
+// printReport logs the rows that were not imported by line, followed by a
+// summary.
+func printReport(records []parsedRecord, parseErrs []parseError, report *domain.ImportReport) {
+	type lineError struct {
+		line int
+		msg  string
+	}
+
+	var errs []lineError
+	for _, e := range parseErrs {
+		errs = append(errs, lineError{line: e.line, msg: e.err.Error()})
+	}
+	for _, e := range report.Errors {
+		fields := make([]string, 0, len(e.FieldErrorMessages))
+		for field, msg := range e.FieldErrorMessages {
+			fields = append(fields, fmt.Sprintf("%s: %s", field, msg))
+		}
+		sort.Strings(fields)
+		errs = append(errs, lineError{line: records[e.Row-1].line, msg: strings.Join(fields, "; ")})
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].line < errs[j].line })
+
+	for _, e := range errs {
+		log.Printf("line %d: %s", e.line, e.msg)
+	}
+	log.Printf("imported %d of %d products", report.Imported, len(records)+len(parseErrs))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ebisaan/inventory/internal/adapter/postgres"
+	"github.com/ebisaan/inventory/internal/application/core/api"
+	port "github.com/ebisaan/inventory/internal/application/port"
+)
+
+const usage = `Usage: catalog -dsn <dsn> <command> [arguments]
+
+Commands:
+  import [-format csv|jsonl] <file>         create the products listed in the file
+  export [-format csv|jsonl] [-o <file>]    write every product to the file or
+                                            to standard output
+`
+
+func main() {
+	dsn := flag.String("dsn", "", "Data connection string")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db, err := postgres.NewAdapter(*dsn)
+	if err != nil {
+		log.Fatalln("failed to create postgres adapter: " + err.Error())
+	}
+
+	app, err := api.NewApplication(db)
+	if err != nil {
+		log.Fatalln("failed to create application: " + err.Error())
+	}
+
+	err = run(context.Background(), app, flag.Arg(0), flag.Args()[1:])
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+}
+
+func run(ctx context.Context, app port.API, cmd string, args []string) error {
+	switch cmd {
+	case "import":
+		return runImport(ctx, app, args)
+	case "export":
+		return runExport(ctx, app, args)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
@@ -10,8 +10,11 @@ import (
 
 	"github.com/ebisaan/inventory/config"
 	"github.com/ebisaan/inventory/internal/adapter/grpc"
+	"github.com/ebisaan/inventory/internal/adapter/notifier"
 	"github.com/ebisaan/inventory/internal/adapter/postgres"
+	"github.com/ebisaan/inventory/internal/adapter/publisher"
 	"github.com/ebisaan/inventory/internal/application/core/api"
+	"github.com/ebisaan/inventory/internal/application/core/domain"
 	"github.com/ebisaan/inventory/internal/logger"
 )
 
@@ -33,6 +36,11 @@ func main() {
 
 	parseFromFlags(&cfg)
 
+	err = cfg.Validate()
+	if err != nil {
+		zap.L().Fatal("Invalid config: " + err.Error())
+	}
+
 	db, err := postgres.NewAdapter(cfg.DB.DSN, postgres.Config{
 		MaxOpenConns: cfg.DB.MaxOpenConns,
 		MaxIdleConns: cfg.DB.MaxIdleConns,
@@ -42,7 +50,38 @@ func main() {
 		zap.L().Fatal("Failed to create postgres adapter" + err.Error())
 	}
 
-	app, err := api.NewApplication(db)
+	var opts []api.Option
+	switch cfg.Outbox.Publisher {
+	case "":
+	case "file":
+		p, err := publisher.NewFile(cfg.Outbox.File)
+		if err != nil {
+			zap.L().Fatal("Failed to create file publisher: " + err.Error())
+		}
+		defer p.Close()
+		opts = append(opts, api.WithPublisher(p))
+	default:
+		zap.L().Fatal("Unknown outbox publisher " + cfg.Outbox.Publisher)
+	}
+
+	switch cfg.Alert.Notifier {
+	case "":
+	case "log":
+		opts = append(opts, api.WithNotifier(notifier.NewLog(zap.L())))
+	case "file":
+		n, err := notifier.NewFile(cfg.Alert.File)
+		if err != nil {
+			zap.L().Fatal("Failed to create file notifier: " + err.Error())
+		}
+		defer n.Close()
+		opts = append(opts, api.WithNotifier(n))
+	default:
+		zap.L().Fatal("Unknown alert notifier " + cfg.Alert.Notifier)
+	}
+
+	opts = append(opts, api.WithCostMethod(domain.CostMethod(cfg.Cost.Method)))
+
+	app, err := api.NewApplication(db, opts...)
 	if err != nil {
 		zap.L().Fatal("Failed to create application adapter" + err.Error())
 	}
@@ -51,6 +90,13 @@ func main() {
 		Port: cfg.Port,
 		Env:  cfg.Env,
 	})
+	grpc.SweepExpiredReservations(cfg.Reservation.SweepInterval)
+	grpc.PurgeDeletedProducts(cfg.Purge.Interval, cfg.Purge.Retention)
+	grpc.PurgeOutboxEvents(cfg.Purge.Interval, cfg.Outbox.Retention)
+	grpc.FeedProductChanges(time.Second)
+	if cfg.Outbox.Publisher != "" || cfg.Alert.Notifier != "" {
+		grpc.RelayEvents(cfg.Outbox.RelayInterval)
+	}
 
 	err = grpc.Run()
 	if err != nil {
@@ -105,5 +151,70 @@ func parseFromFlags(cfg *config.Config) {
 		return nil
 	})
 
+	flag.Func("reservation-sweep-interval", "Interval between releases of expired stock reservations", func(s string) error {
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid reservation-sweep-interval: %w", err)
+		}
+		cfg.Reservation.SweepInterval = dur
+
+		return nil
+	})
+
+	flag.Func("purge-interval", "Interval between purges of soft deleted products", func(s string) error {
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid purge-interval: %w", err)
+		}
+		cfg.Purge.Interval = dur
+
+		return nil
+	})
+
+	flag.Func("purge-retention", "How long soft deleted products are kept before they are purged", func(s string) error {
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid purge-retention: %w", err)
+		}
+		cfg.Purge.Retention = dur
+
+		return nil
+	})
+
+	flag.Func("outbox-publisher", "Publisher of the outbox events: file", func(s string) error {
+		cfg.Outbox.Publisher = s
+		return nil
+	})
+
+	flag.Func("outbox-file", "File the file publisher appends the outbox events to", func(s string) error {
+		cfg.Outbox.File = s
+		return nil
+	})
+
+	flag.Func("outbox-retention", "How long outbox events are kept before they are purged", func(s string) error {
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid outbox-retention: %w", err)
+		}
+		cfg.Outbox.Retention = dur
+
+		return nil
+	})
+
+	flag.Func("alert-notifier", "Notifier of the low stock alerts: log or file", func(s string) error {
+		cfg.Alert.Notifier = s
+		return nil
+	})
+
+	flag.Func("alert-file", "File the file notifier appends the low stock alerts to", func(s string) error {
+		cfg.Alert.File = s
+		return nil
+	})
+
+	flag.Func("cost-method", "Costing method of received goods: fifo or weighted_average", func(s string) error {
+		cfg.Cost.Method = s
+		return nil
+	})
+
 	flag.Parse()
 }
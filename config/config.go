@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"time"
 
@@ -23,6 +24,34 @@ type Config struct {
 		MaxIdleConns int           `yaml:"max_idle_conns" default:"50"`
 		MaxIdleTime  time.Duration `yaml:"max_idle_time" default:"1m"`
 	}
+	Reservation struct {
+		SweepInterval time.Duration `yaml:"sweep_interval" default:"1m"`
+	}
+	Purge struct {
+		Interval  time.Duration `yaml:"interval" default:"1h"`
+		Retention time.Duration `yaml:"retention" default:"720h"`
+	}
+	// Outbox events are only relayed when a publisher ("file") or an alert
+	// notifier is set; without a publisher, only the low stock alerts go
+	// out. They are written either way, for the product watchers, and
+	// purged once older than Retention.
+	Outbox struct {
+		Publisher     string        `yaml:"publisher"`
+		File          string        `yaml:"file" default:"events.jsonl"`
+		RelayInterval time.Duration `yaml:"relay_interval" default:"1s"`
+		Retention     time.Duration `yaml:"retention" default:"168h"`
+	}
+	// Low stock alerts are relayed from the outbox to the notifier, either
+	// "log" or "file", when one is set.
+	Alert struct {
+		Notifier string `yaml:"notifier"`
+		File     string `yaml:"file" default:"alerts.jsonl"`
+	}
+	// Cost is how received goods are costed: "fifo" or
+	// "weighted_average".
+	Cost struct {
+		Method string `yaml:"method" default:"fifo"`
+	}
 }
 
 func (c *Config) ReadFrom(filePath string) error {
@@ -51,3 +80,23 @@ func (c *Config) ReadFrom(filePath string) error {
 
 	return nil
 }
+
+// Validate checks the settings that would otherwise fail only once the
+// server runs, such as the intervals of the background workers.
+func (c *Config) Validate() error {
+	intervals := []struct {
+		name string
+		d    time.Duration
+	}{
+		{"reservation.sweep_interval", c.Reservation.SweepInterval},
+		{"purge.interval", c.Purge.Interval},
+		{"outbox.relay_interval", c.Outbox.RelayInterval},
+	}
+	for _, i := range intervals {
+		if i.d <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", i.name, i.d)
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ require (
 	github.com/go-playground/validator/v10 v10.17.0
 	github.com/goccy/go-yaml v1.11.3
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.0.1
+	github.com/jackc/pgx/v5 v5.4.3
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240205150955-31a09d347014
 	google.golang.org/grpc v1.61.1
 	gorm.io/gorm v1.25.5
@@ -37,7 +38,6 @@ require (
 	github.com/google/uuid v1.4.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
-	github.com/jackc/pgx/v5 v5.4.3 // indirect
 	github.com/klauspost/compress v1.16.0 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
 	github.com/leodido/go-urn v1.2.4 // indirect
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// actorMetadataKey is the request metadata naming the user or service on
+// whose behalf the call is made. Changes are recorded in the product history
+// under that name.
+const actorMetadataKey = "x-actor"
+
+func actorUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if actors := metadata.ValueFromIncomingContext(ctx, actorMetadataKey); len(actors) > 0 {
+		ctx = domain.ContextWithActor(ctx, actors[0])
+	}
+
+	return handler(ctx, req)
+}
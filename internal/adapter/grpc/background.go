@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SweepExpiredReservations releases expired stock reservations every interval
+// until the server shuts down.
+func (a *Adapter) SweepExpiredReservations(interval time.Duration) {
+	a.every(interval, a.app.ReleaseExpiredReservations, "Released %d expired reservations")
+}
+
+// PurgeDeletedProducts permanently removes the products soft deleted longer
+// than retention ago, every interval until the server shuts down.
+func (a *Adapter) PurgeDeletedProducts(interval, retention time.Duration) {
+	a.every(interval, func(ctx context.Context) (int64, error) {
+		return a.app.PurgeDeletedProducts(ctx, retention)
+	}, "Purged %d deleted products")
+}
+
+// PurgeOutboxEvents deletes the outbox events older than retention, every
+// interval until the server shuts down.
+func (a *Adapter) PurgeOutboxEvents(interval, retention time.Duration) {
+	a.every(interval, func(ctx context.Context) (int64, error) {
+		return a.app.PurgeOutboxEvents(ctx, retention)
+	}, "Purged %d outbox events")
+}
+
+// RelayEvents publishes the events waiting in the outbox every interval
+// until the server shuts down.
+func (a *Adapter) RelayEvents(interval time.Duration) {
+	a.every(interval, a.app.RelayEvents, "Relayed %d outbox events")
+}
+
+// every runs fn every interval until the server shuts down. Errors are
+// logged and the next tick tries again; the count fn returns is logged with
+// logFormat when it is not zero.
+func (a *Adapter) every(interval time.Duration, fn func(ctx context.Context) (int64, error), logFormat string) {
+	a.Background(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-a.Done:
+				return
+			case <-ticker.C:
+				n, err := fn(context.Background())
+				if err != nil {
+					zap.L().Error(err.Error())
+					continue
+				}
+
+				if n > 0 {
+					zap.L().Info(fmt.Sprintf(logFormat, n))
+				}
+			}
+		}
+	})
+}
+
+// FeedProductChanges feeds the product watchers with the changes committed
+// to the database until the server shuts down. The feed is restarted after
+// retry when the database connection fails.
+func (a *Adapter) FeedProductChanges(retry time.Duration) {
+	a.Background(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-a.Done
+			cancel()
+		}()
+
+		for {
+			err := a.app.RunProductChangeFeed(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				zap.L().Error(err.Error())
+			}
+
+			select {
+			case <-a.Done:
+				return
+			case <-time.After(retry):
+			}
+		}
+	})
+}
@@ -80,7 +80,21 @@ func (a *Adapter) CreateProduct(ctx context.Context, req *inventoryv1.CreateProd
 }
 
 func (a *Adapter) UpdateProduct(ctx context.Context, req *inventoryv1.UpdateProductRequest) (*inventoryv1.UpdateProductResponse, error) {
-	err := a.app.UpdateProduct(ctx, updatedDomainProduct(req))
+	update := updatedDomainProduct(req)
+
+	// The proto has no reorder fields yet, so the stored ones are kept.
+	current, err := a.app.GetProductByID(ctx, req.Id)
+	switch {
+	case err == nil:
+		update.ReorderPoint = current.ReorderPoint
+		update.ReorderQuantity = current.ReorderQuantity
+	case !errors.Is(err, domain.ErrNotFound):
+		zap.L().Error(err.Error())
+
+		return nil, status.New(codes.Unknown, "Unknown Error").Err()
+	}
+
+	err = a.app.UpdateProduct(ctx, update)
 	if err != nil {
 		validationErr := &domain.ValidationError{}
 		switch {
@@ -112,6 +126,9 @@ func (a *Adapter) DeleteProduct(ctx context.Context, req *inventoryv1.DeleteProd
 		case errors.Is(err, domain.ErrEditConflict):
 			st := status.New(codes.FailedPrecondition, domain.ErrEditConflict.Error())
 			return nil, st.Err()
+		case errors.Is(err, domain.ErrInUse):
+			st := status.New(codes.FailedPrecondition, "product has held reservations")
+			return nil, st.Err()
 		default:
 			zap.L().Error(err.Error())
 
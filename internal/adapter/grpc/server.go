@@ -50,8 +50,9 @@ func (a *Adapter) Run() error {
 	}
 
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(
+		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(),
+			actorUnaryInterceptor,
 		),
 		grpc.StreamInterceptor(
 			recovery.StreamServerInterceptor(),
@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	"github.com/ebisaan/inventory/internal/application/port"
+)
+
+var _ port.Notifier = (*File)(nil)
+
+// File appends the low stock alerts to a file, one JSON object per line.
+type File struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+type fileAlert struct {
+	ProductID       int64     `json:"product_id"`
+	SKU             string    `json:"sku,omitempty"`
+	Name            string    `json:"name"`
+	StockNumber     int       `json:"stock_number"`
+	ReorderPoint    int       `json:"reorder_point"`
+	ReorderQuantity int       `json:"reorder_quantity"`
+	RaisedAt        time.Time `json:"raised_at"`
+}
+
+func NewFile(path string) (*File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open alert file: %w", err)
+	}
+
+	return &File{f: f}, nil
+}
+
+// NotifyLowStock writes the alert and syncs the file before returning.
+func (n *File) NotifyLowStock(_ context.Context, alert *domain.LowStockAlert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err := json.NewEncoder(n.f).Encode(fileAlert{
+		ProductID:       alert.ProductID,
+		SKU:             alert.SKU,
+		Name:            alert.Name,
+		StockNumber:     alert.StockNumber,
+		ReorderPoint:    alert.ReorderPoint,
+		ReorderQuantity: alert.ReorderQuantity,
+		RaisedAt:        alert.RaisedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("write low stock alert of product id=%d: %w", alert.ProductID, err)
+	}
+
+	err = n.f.Sync()
+	if err != nil {
+		return fmt.Errorf("sync alert file: %w", err)
+	}
+
+	return nil
+}
+
+func (n *File) Close() error {
+	return n.f.Close()
+}
@@ -0,0 +1,43 @@
+package notifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ebisaan/inventory/internal/adapter/notifier"
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	n, err := notifier.NewFile(path)
+	require.NoError(t, err)
+
+	err = n.NotifyLowStock(context.Background(), &domain.LowStockAlert{
+		ProductID:       7,
+		Name:            "Songoku",
+		StockNumber:     4,
+		ReorderPoint:    5,
+		ReorderQuantity: 20,
+		RaisedAt:        time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NoError(t, n.Close())
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"product_id": 7,
+		"name": "Songoku",
+		"stock_number": 4,
+		"reorder_point": 5,
+		"reorder_quantity": 20,
+		"raised_at": "2024-03-01T09:30:00Z"
+	}`, string(got))
+}
@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	"github.com/ebisaan/inventory/internal/application/port"
+)
+
+var _ port.Notifier = (*Log)(nil)
+
+// Log writes the low stock alerts to the log as warnings.
+type Log struct {
+	lgr *zap.Logger
+}
+
+func NewLog(lgr *zap.Logger) *Log {
+	return &Log{lgr: lgr}
+}
+
+func (l *Log) NotifyLowStock(_ context.Context, alert *domain.LowStockAlert) error {
+	l.lgr.Warn("Product is low on stock",
+		zap.Int64("product_id", alert.ProductID),
+		zap.String("sku", alert.SKU),
+		zap.String("name", alert.Name),
+		zap.Int("stock_number", alert.StockNumber),
+		zap.Int("reorder_point", alert.ReorderPoint),
+		zap.Int("reorder_quantity", alert.ReorderQuantity),
+		zap.Time("raised_at", alert.RaisedAt),
+	)
+
+	return nil
+}
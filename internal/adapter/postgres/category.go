@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) GetCategories(ctx context.Context) ([]*domain.MainCategory, error) {
+	db := a.db.WithContext(ctx)
+
+	var categories []*MainCategory
+	err := db.Preload("SubCategories", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name")
+	}).Order("name").Find(&categories).Error
+	if err != nil {
+		return nil, fmt.Errorf("select categories: %w", err)
+	}
+
+	return domainMainCategories(categories), nil
+}
+
+func (a *Adapter) CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	c := &MainCategory{Name: req.Name}
+	err := db.Create(c).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		default:
+			return 0, fmt.Errorf("insert main category: %w", err)
+		}
+	}
+
+	return c.ID, nil
+}
+
+func (a *Adapter) UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Model(&MainCategory{}).Where("id = ?", req.ID).Update("name", req.Name)
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return domain.ErrAlreadyExists
+		default:
+			return fmt.Errorf("update main category id=%d: %w", req.ID, err)
+		}
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteMainCategory fails with domain.ErrInUse while the main category still
+// has subcategories.
+func (a *Adapter) DeleteMainCategory(ctx context.Context, id int64) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Where("id = ?", id).
+		Where("NOT EXISTS (?)", db.Model(&SubCategory{}).Select("1").Where("main_category_id = ?", id)).
+		Delete(&MainCategory{})
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return domain.ErrInUse
+		default:
+			return fmt.Errorf("delete main category id=%d: %w", id, err)
+		}
+	}
+
+	if res.RowsAffected == 0 {
+		var found bool
+		err := db.Model(&MainCategory{}).Select("count(*) > 0").Where("id = ?", id).Take(&found).Error
+		if err != nil {
+			return fmt.Errorf("select main category by id=%d: %w", id, err)
+		}
+		if !found {
+			return domain.ErrNotFound
+		}
+
+		return domain.ErrInUse
+	}
+
+	return nil
+}
+
+func (a *Adapter) CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	mcID, err := getMainCategoryIDByName(db, req.MainCategory)
+	if err != nil {
+		return 0, err
+	}
+
+	c := &SubCategory{Name: req.Name, MainCategoryID: mcID}
+	err = db.Omit(clause.Associations).Create(c).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return 0, domain.ErrAssociationNotFound
+		default:
+			return 0, fmt.Errorf("insert subcategory: %w", err)
+		}
+	}
+
+	return c.ID, nil
+}
+
+func (a *Adapter) UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error {
+	db := a.db.WithContext(ctx)
+
+	updates := map[string]any{}
+	if req.Name != "" {
+		updates["name"] = req.Name
+	}
+	if req.MainCategory != "" {
+		mcID, err := getMainCategoryIDByName(db, req.MainCategory)
+		if err != nil {
+			return err
+		}
+		updates["main_category_id"] = mcID
+	}
+
+	if len(updates) == 0 {
+		var found bool
+		err := db.Model(&SubCategory{}).Select("count(*) > 0").Where("id = ?", req.ID).Take(&found).Error
+		if err != nil {
+			return fmt.Errorf("select subcategory by id=%d: %w", req.ID, err)
+		}
+		if !found {
+			return domain.ErrNotFound
+		}
+
+		return nil
+	}
+
+	res := db.Model(&SubCategory{}).Where("id = ?", req.ID).Updates(updates)
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return domain.ErrAlreadyExists
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return domain.ErrAssociationNotFound
+		default:
+			return fmt.Errorf("update subcategory id=%d: %w", req.ID, err)
+		}
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteSubCategory moves the products of the subcategory to
+// req.ReassignTo, if given, and deletes the subcategory. It fails with
+// domain.ErrInUse while products still reference the subcategory.
+func (a *Adapter) DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	c := &SubCategory{}
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(c, req.ID).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return domain.ErrNotFound
+		default:
+			return fmt.Errorf("select subcategory by id=%d: %w", req.ID, err)
+		}
+	}
+
+	if req.ReassignTo != "" {
+		scID, err := getSubcategoryIDByName(tx, req.ReassignTo)
+		if err != nil {
+			return err
+		}
+		if scID == c.ID {
+			return domain.ErrInUse
+		}
+
+		// Soft deleted products are moved too, as they still reference the
+		// subcategory. The move is a change of each product like any other.
+		var ids []int64
+		err = tx.Raw(`UPDATE products
+			SET sub_category_id = ?, version = version + 1, updated_at = now()
+			WHERE sub_category_id = ?
+			RETURNING id`, scID, c.ID).
+			Scan(&ids).
+			Error
+		if err != nil {
+			return fmt.Errorf("reassign products of subcategory id=%d: %w", c.ID, err)
+		}
+
+		err = recordProductChanges(tx, ids, domain.ProductUpdated)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Delete(c).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return domain.ErrInUse
+		default:
+			return fmt.Errorf("delete subcategory id=%d: %w", c.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func getMainCategoryIDByName(db *gorm.DB, name string) (int64, error) {
+	var id int64
+	err := db.Model(&MainCategory{}).Select("id").Where("name = ?", name).First(&id).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return 0, domain.ErrAssociationNotFound
+		default:
+			return 0, fmt.Errorf("select main category id: %w", err)
+		}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// GetCostLayers lists the cost layers of a product that still have units in
+// stock, oldest first.
+func (a *Adapter) GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error) {
+	db := a.db.WithContext(ctx)
+
+	var found bool
+	err := db.Model(&Product{}).Select("count(*) > 0").Where("id = ?", productID).Take(&found).Error
+	if err != nil {
+		return nil, fmt.Errorf("select product by id=%d: %w", productID, err)
+	}
+	if !found {
+		return nil, domain.ErrNotFound
+	}
+
+	var layers []CostLayer
+	err = db.Joins("Currency").
+		Where("cost_layers.product_id = ? AND cost_layers.remaining_quantity > 0", productID).
+		Order("cost_layers.created_at, cost_layers.id").
+		Find(&layers).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select cost layers of product id=%d: %w", productID, err)
+	}
+
+	return domainCostLayers(layers), nil
+}
+
+// GetValuation sums the cost of the units left in the cost layers by
+// subcategory and currency, and counts the units without a known cost
+// apart.
+func (a *Adapter) GetValuation(ctx context.Context) ([]domain.ValuationLine, error) {
+	db := a.db.WithContext(ctx)
+
+	var lines []domain.ValuationLine
+	err := db.Table("cost_layers").
+		Select(`main_categories.name AS main_category,
+			sub_categories.name AS sub_category,
+			currencies.code AS currency_code,
+			COALESCE(SUM(cost_layers.remaining_quantity) FILTER (WHERE cost_layers.unit_cost IS NOT NULL), 0) AS quantity,
+			COALESCE(SUM(cost_layers.remaining_quantity) FILTER (WHERE cost_layers.unit_cost IS NULL), 0) AS uncosted_quantity,
+			COALESCE(SUM(cost_layers.remaining_quantity * cost_layers.unit_cost), 0) AS value`).
+		Joins("JOIN products ON products.id = cost_layers.product_id").
+		Joins("JOIN sub_categories ON sub_categories.id = products.sub_category_id").
+		Joins("JOIN main_categories ON main_categories.id = sub_categories.main_category_id").
+		Joins("JOIN currencies ON currencies.id = cost_layers.currency_id").
+		Where("cost_layers.remaining_quantity > 0 AND products.deleted_at IS NULL").
+		Group("main_categories.name, sub_categories.name, currencies.code").
+		Order("main_categories.name, sub_categories.name, currencies.code").
+		Scan(&lines).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select valuation: %w", err)
+	}
+
+	return lines, nil
+}
+
+// addCostLayer records the cost of received units. With the weighted
+// average method the open costed layers of the product in the same currency
+// are folded into the new one.
+func addCostLayer(tx *gorm.DB, layer *CostLayer, method domain.CostMethod) error {
+	layer.RemainingQuantity = layer.Quantity
+
+	if method == domain.CostWeightedAverage {
+		var open []CostLayer
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("product_id = ? AND currency_id = ? AND remaining_quantity > 0", layer.ProductID, layer.CurrencyID).
+			Where("unit_cost IS NOT NULL").
+			Find(&open).
+			Error
+		if err != nil {
+			return fmt.Errorf("select cost layers of product id=%d: %w", layer.ProductID, err)
+		}
+
+		if len(open) > 0 {
+			value := float64(layer.Quantity) * *layer.UnitCost
+			ids := make([]int64, len(open))
+			for i, l := range open {
+				value += float64(l.RemainingQuantity) * *l.UnitCost
+				layer.RemainingQuantity += l.RemainingQuantity
+				ids[i] = l.ID
+			}
+			average := value / float64(layer.RemainingQuantity)
+			layer.UnitCost = &average
+
+			err = tx.Model(&CostLayer{}).Where("id IN ?", ids).Update("remaining_quantity", 0).Error
+			if err != nil {
+				return fmt.Errorf("fold cost layers of product id=%d: %w", layer.ProductID, err)
+			}
+		}
+	}
+
+	err := tx.Omit(clause.Associations).Create(layer).Error
+	if err != nil {
+		return fmt.Errorf("insert cost layer of product id=%d: %w", layer.ProductID, err)
+	}
+
+	return nil
+}
+
+// applyCostLayers keeps the cost layers of the product in line with a stock
+// movement. Only units leaving stock for good are taken from the layers:
+// held units are consumed when their reservation is confirmed, so holds and
+// releases leave the layers alone. Units coming in without a cost, such as
+// the opening stock, manual receipts, returns and positive adjustments, get
+// an uncosted layer. Costed receipts are added by the caller with
+// addCostLayer, transfers only move units between warehouses, and the stock
+// of variants is not costed.
+func applyCostLayers(tx *gorm.DB, m *StockMovement) error {
+	switch {
+	case m.VariantID != nil, m.costed:
+		return nil
+	case m.Reason == string(domain.MovementReservation), m.Reason == string(domain.MovementRelease):
+		return nil
+	case m.Reason == string(domain.MovementTransfer):
+		return nil
+	case m.Quantity < 0:
+		return consumeCostLayers(tx, m.ProductID, -m.Quantity)
+	case m.Quantity > 0:
+		return addUncostedLayer(tx, m)
+	}
+
+	return nil
+}
+
+func addUncostedLayer(tx *gorm.DB, m *StockMovement) error {
+	var currencyID int64
+	err := tx.Model(&Product{}).Select("currency_id").Where("id = ?", m.ProductID).Take(&currencyID).Error
+	if err != nil {
+		return fmt.Errorf("select currency of product id=%d: %w", m.ProductID, err)
+	}
+
+	err = tx.Omit(clause.Associations).Create(&CostLayer{
+		ProductID:         m.ProductID,
+		CurrencyID:        currencyID,
+		Quantity:          m.Quantity,
+		RemainingQuantity: m.Quantity,
+		Reference:         m.Reference,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("insert cost layer of product id=%d: %w", m.ProductID, err)
+	}
+
+	return nil
+}
+
+// consumeCostLayers takes units from the open layers of the product, in the
+// order they came in, whether costed or not. With the weighted average
+// method a currency only has one open costed layer, so the units leave at
+// its average cost.
+func consumeCostLayers(tx *gorm.DB, productID int64, quantity int) error {
+	var open []CostLayer
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id = ? AND remaining_quantity > 0", productID).
+		Order("created_at, id").
+		Find(&open).
+		Error
+	if err != nil {
+		return fmt.Errorf("select cost layers of product id=%d: %w", productID, err)
+	}
+
+	for i := 0; i < len(open) && quantity > 0; i++ {
+		n := min(open[i].RemainingQuantity, quantity)
+		quantity -= n
+
+		err = tx.Model(&open[i]).Update("remaining_quantity", open[i].RemainingQuantity-n).Error
+		if err != nil {
+			return fmt.Errorf("consume cost layer id=%d: %w", open[i].ID, err)
+		}
+	}
+
+	return nil
+}
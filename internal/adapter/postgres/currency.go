@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) GetCurrency(ctx context.Context, code string) (*domain.Currency, error) {
+	db := a.db.WithContext(ctx)
+
+	c := &Currency{}
+	err := db.Where("code = ?", code).First(c).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select currency by code=%s: %w", code, err)
+		}
+	}
+
+	return domainCurrency(c), nil
+}
+
+func (a *Adapter) GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error) {
+	db := a.db.WithContext(ctx)
+
+	query := db.Order("code")
+	if !includeDisabled {
+		query = query.Where("disabled = ?", false)
+	}
+
+	var currencies []*Currency
+	err := query.Find(&currencies).Error
+	if err != nil {
+		return nil, fmt.Errorf("select currencies: %w", err)
+	}
+
+	return domainCurrencies(currencies), nil
+}
+
+func (a *Adapter) CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	c := &Currency{
+		Code:   req.Code,
+		Symbol: req.Symbol,
+	}
+	err := db.Create(c).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		default:
+			return 0, fmt.Errorf("insert currency: %w", err)
+		}
+	}
+
+	return c.ID, nil
+}
+
+func (a *Adapter) SetCurrencyDisabled(ctx context.Context, code string, disabled bool) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Model(&Currency{}).Where("code = ?", code).Update("disabled", disabled)
+	if err := res.Error; err != nil {
+		return fmt.Errorf("update currency code=%s: %w", code, err)
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// SetExchangeRate stores the rate of a currency pair for a day, replacing the
+// rate already stored for that day.
+func (a *Adapter) SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error {
+	db := a.db.WithContext(ctx)
+
+	baseID, err := getCurrencyIDByCode(db, req.BaseCurrency)
+	if err != nil {
+		return err
+	}
+	quoteID, err := getCurrencyIDByCode(db, req.QuoteCurrency)
+	if err != nil {
+		return err
+	}
+
+	r := &ExchangeRate{
+		BaseCurrencyID:  baseID,
+		QuoteCurrencyID: quoteID,
+		Rate:            req.Rate,
+		EffectiveDate:   req.EffectiveDate.UTC().Truncate(24 * time.Hour),
+	}
+	err = db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base_currency_id"}, {Name: "quote_currency_id"}, {Name: "effective_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(r).Error
+	if err != nil {
+		return fmt.Errorf("upsert exchange rate %s/%s: %w", req.BaseCurrency, req.QuoteCurrency, err)
+	}
+
+	return nil
+}
+
+// GetExchangeRate returns the latest rate of the pair effective on or before
+// the day of at.
+func (a *Adapter) GetExchangeRate(ctx context.Context, base, quote string, at time.Time) (*domain.ExchangeRate, error) {
+	db := a.db.WithContext(ctx)
+
+	r := &ExchangeRate{}
+	err := db.Joins("BaseCurrency").Joins("QuoteCurrency").
+		Where(`"BaseCurrency".code = ?`, base).
+		Where(`"QuoteCurrency".code = ?`, quote).
+		Where("exchange_rates.effective_date <= ?", at.UTC().Truncate(24*time.Hour)).
+		Order("exchange_rates.effective_date DESC").
+		First(r).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select exchange rate %s/%s: %w", base, quote, err)
+		}
+	}
+
+	return domainExchangeRate(r), nil
+}
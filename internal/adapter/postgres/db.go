@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -57,46 +58,108 @@ func (a *Adapter) InsertProduct(ctx context.Context, domainProduct *domain.Produ
 }
 
 func (a *Adapter) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
-	db := a.db.WithContext(ctx)
+	return firstProduct(a.db.WithContext(ctx), "products.id = ?", id)
+}
+
+func (a *Adapter) GetProductBySKU(ctx context.Context, sku string) (*domain.Product, error) {
+	return firstProduct(a.db.WithContext(ctx), "products.sku = ?", sku)
+}
+
+func (a *Adapter) GetProductByBarcode(ctx context.Context, barcode string) (*domain.Product, error) {
+	return firstProduct(a.db.WithContext(ctx), "products.barcode = ?", barcode)
+}
+
+// firstProduct returns the product matching the condition with its
+// associations, or domain.ErrNotFound.
+func firstProduct(db *gorm.DB, query string, args ...any) (*domain.Product, error) {
 	product := &Product{}
-	err := db.Joins("SubCategory.MainCategory").Joins("Currency").First(product, id).Error
+	err := db.Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Where(query, args...).
+		First(product).
+		Error
 	if err != nil {
 		switch {
 		case errors.Is(err, gorm.ErrRecordNotFound):
 			return nil, domain.ErrNotFound
 		default:
-			return nil, fmt.Errorf("select product by id=%d: %w", id, err)
+			return nil, fmt.Errorf("select product where %s %v: %w", query, args, err)
 		}
 	}
 
 	return domainProduct(product), nil
 }
 
+// GetProducts returns a page of products. In keyset mode the page is taken
+// after or before filter.Position rather than at an offset, and the
+// products are always returned in the order of the filter.
 func (a *Adapter) GetProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
 	db := a.db.WithContext(ctx)
 
 	var products []*Product
-	query := db.Model(&products)
+	query := filterProducts(db.Model(&products), filter)
 
 	var total int64 = 0
-	err := query.Count(&total).Error
-	if err != nil {
-		return 0, nil, fmt.Errorf("count products: %w", err)
+	if !filter.SkipCount {
+		err := query.Count(&total).Error
+		if err != nil {
+			return 0, nil, fmt.Errorf("count products: %w", err)
+		}
+		if total == 0 {
+			return 0, domainProducts(products), nil
+		}
 	}
-	if total > 0 {
-		err := query.Joins("SubCategory.MainCategory").Joins("Currency").
-			Limit(filter.Limit()).
-			Offset(int(filter.Offset())).
-			Find(&products).
-			Error
+
+	switch {
+	case filter.Position != nil:
+		cond, err := keysetCondition(filter)
 		if err != nil {
-			return 0, nil, fmt.Errorf("select products: %w", err)
+			return 0, nil, err
 		}
+		query = query.Where(cond)
+	case !filter.IsKeyset():
+		query = query.Offset(int(filter.Offset()))
+	}
+
+	err := query.Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Order(productOrder(filter)).
+		Limit(filter.Limit()).
+		Find(&products).
+		Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("select products: %w", err)
+	}
+
+	if filter.Position != nil && filter.Position.Backward {
+		slices.Reverse(products)
 	}
 
 	return total, domainProducts(products), nil
 }
 
+func (a *Adapter) GetProductsByIDs(ctx context.Context, ids []int64) ([]*domain.Product, error) {
+	db := a.db.WithContext(ctx)
+
+	var products []*Product
+	err := db.Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Where("products.id IN ?", ids).
+		Find(&products).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select products by ids: %w", err)
+	}
+
+	return domainProducts(products), nil
+}
+
 func (a *Adapter) CreateProduct(ctx context.Context, dp *domain.CreateProductRequest) (id int64, err error) {
 	db := a.db.WithContext(ctx)
 
@@ -130,7 +193,22 @@ func (a *Adapter) CreateProduct(ctx context.Context, dp *domain.CreateProductReq
 
 	err = tx.Omit(clause.Associations).Create(&p).Error
 	if err != nil {
-		return 0, fmt.Errorf("insert product: %w", err)
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		default:
+			return 0, fmt.Errorf("insert product: %w", err)
+		}
+	}
+
+	err = recordProductChanges(tx, []int64{p.ID}, domain.ProductCreated)
+	if err != nil {
+		return 0, err
+	}
+
+	err = recordInitialStock(tx, []*Product{p})
+	if err != nil {
+		return 0, err
 	}
 
 	return p.ID, nil
@@ -169,36 +247,115 @@ func (a *Adapter) UpdateProduct(ctx context.Context, dp *domain.UpdateProductReq
 	}
 	p.CurrencyID = crcID
 
+	prevStock, err := lockStockNumber(tx, p.ID, curVersion)
+	if err != nil {
+		return err
+	}
+
 	res := tx.Omit(clause.Associations).Where("id = ?", p.ID).Where("version = ?", curVersion).Updates(&p)
 	if err := res.Error; err != nil {
-		return fmt.Errorf("select product by id=%d: %w", p.ID, err)
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return domain.ErrAlreadyExists
+		default:
+			return fmt.Errorf("select product by id=%d: %w", p.ID, err)
+		}
 	}
 
 	if res.RowsAffected == 0 {
 		return domain.ErrEditConflict
 	}
 
-	return nil
+	// Updates skips the zero values, so the reorder fields are written on
+	// their own: a nil ReorderPoint clears the point.
+	err = tx.Model(&Product{}).Where("id = ?", p.ID).Updates(map[string]any{
+		"reorder_point":    p.ReorderPoint,
+		"reorder_quantity": p.ReorderQuantity,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("update reorder point of product id=%d: %w", p.ID, err)
+	}
+
+	var level stockLevel
+	err = tx.Model(&Product{}).Select(stockLevelColumns).Where("id = ?", p.ID).Take(&level).Error
+	if err != nil {
+		return fmt.Errorf("select stock of product id=%d: %w", p.ID, err)
+	}
+
+	if level.StockNumber != prevStock {
+		err = insertStockMovement(tx, &StockMovement{
+			ProductID:  p.ID,
+			Reason:     string(domain.MovementAdjustment),
+			Quantity:   level.StockNumber - prevStock,
+			StockAfter: level.StockNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = recordLowStock(tx, level, prevStock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return recordProductChanges(tx, []int64{p.ID}, domain.ProductUpdated)
 }
 
-func (a *Adapter) DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error {
+// DeleteProduct soft deletes the product, which hides it from every read
+// until it is restored or purged. A product with held reservations cannot be
+// deleted, as releasing them gives the units back to its stock.
+func (a *Adapter) DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) (err error) {
 	db := a.db.WithContext(ctx)
 
-	res := db.Where("id = ?", req.ID).Where("version = ?", req.Version).Delete(&Product{})
-	if err := res.Error; err != nil {
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", req.ID).
+		Where("version = ?", req.Version).
+		Take(&Product{}).
+		Error
+	if err != nil {
 		switch {
 		case errors.Is(err, gorm.ErrRecordNotFound):
 			return domain.ErrEditConflict
 		default:
-			return err
+			return fmt.Errorf("lock product id=%d: %w", req.ID, err)
 		}
 	}
 
-	if res.RowsAffected == 0 {
-		return domain.ErrEditConflict
+	var held bool
+	err = tx.Model(&Reservation{}).
+		Select("count(*) > 0").
+		Where("product_id = ?", req.ID).
+		Where("status = ?", string(domain.ReservationHeld)).
+		Take(&held).
+		Error
+	if err != nil {
+		return fmt.Errorf("select held reservations of product id=%d: %w", req.ID, err)
+	}
+	if held {
+		return domain.ErrInUse
 	}
 
-	return nil
+	err = tx.Where("id = ?", req.ID).Delete(&Product{}).Error
+	if err != nil {
+		return fmt.Errorf("delete product id=%d: %w", req.ID, err)
+	}
+
+	return recordProductChanges(tx, []int64{req.ID}, domain.ProductDeleted)
 }
 
 func (a *Adapter) IsSubCategoryExists(ctx context.Context, name string) (bool, error) {
@@ -216,12 +373,14 @@ func (a *Adapter) IsSubCategoryExists(ctx context.Context, name string) (bool, e
 	return found, nil
 }
 
+// IsCurrencyCodeExists reports whether an enabled currency has the code.
 func (a *Adapter) IsCurrencyCodeExists(ctx context.Context, code string) (bool, error) {
 	var found bool
 	err := a.db.
 		Model(&Currency{}).
 		Select("count(*) > 0").
 		Where("code = ?", code).
+		Where("disabled = ?", false).
 		Take(&found).
 		Error
 	if err != nil {
@@ -231,6 +390,30 @@ func (a *Adapter) IsCurrencyCodeExists(ctx context.Context, code string) (bool,
 	return found, nil
 }
 
+// lockStockNumber locks the product row for the rest of the transaction and
+// returns its stock number. It fails with domain.ErrEditConflict when the
+// product is not at the given version.
+func lockStockNumber(tx *gorm.DB, id int64, version int64) (int, error) {
+	var stock int
+	err := tx.Model(&Product{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("stock_number").
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Take(&stock).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return 0, domain.ErrEditConflict
+		default:
+			return 0, fmt.Errorf("select stock of product id=%d: %w", id, err)
+		}
+	}
+
+	return stock, nil
+}
+
 func getSubcategoryIDByName(db *gorm.DB, name string) (int64, error) {
 	var id int64
 	err := db.Model(&SubCategory{}).Select("id").Where("name = ?", name).First(&id).Error
@@ -260,13 +443,3 @@ func getCurrencyIDByCode(db *gorm.DB, code string) (int64, error) {
 
 	return id, nil
 }
-
-func (a *Adapter) AutoMigration(ctx context.Context) error {
-	db := a.db.WithContext(ctx)
-	err := db.AutoMigrate(&Currency{}, &MainCategory{}, &SubCategory{}, &Product{})
-	if err != nil {
-		return fmt.Errorf("auto migration: %w", err)
-	}
-
-	return nil
-}
@@ -2,9 +2,15 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/docker/go-connections/nat"
 	_ "github.com/lib/pq"
@@ -96,6 +102,575 @@ func (s *DatabaseTestSuite) TestGetProducts() {
 	})
 }
 
+func (s *DatabaseTestSuite) TestGetProducts_Filter() {
+	songoku, gshock := s.domainProducts[0], s.domainProducts[1]
+
+	tests := []struct {
+		name   string
+		filter domain.Filter
+		want   []*domain.Product
+	}{
+		{name: "main category", filter: domain.Filter{MainCategory: "toys & baby products"}, want: []*domain.Product{songoku}},
+		{name: "sub category", filter: domain.Filter{SubCategory: "Watches"}, want: []*domain.Product{gshock}},
+		{name: "currency", filter: domain.Filter{CurrencyCode: "VND"}, want: []*domain.Product{songoku}},
+		{name: "price range", filter: domain.Filter{MinPrice: 100, MaxPrice: 1000}, want: []*domain.Product{gshock}},
+		{name: "in stock", filter: domain.Filter{InStockOnly: true}, want: []*domain.Product{songoku, gshock}},
+		{name: "name substring", filter: domain.Filter{Name: "shoc"}, want: []*domain.Product{gshock}},
+		{name: "no match", filter: domain.Filter{Name: "100%"}, want: []*domain.Product{}},
+		{
+			name:   "sort by price desc",
+			filter: domain.Filter{SortBy: domain.SortByPrice, SortDirection: domain.SortDesc},
+			want:   []*domain.Product{songoku, gshock},
+		},
+		{
+			name:   "sort by stock desc",
+			filter: domain.Filter{SortBy: domain.SortByStock, SortDirection: domain.SortDesc},
+			want:   []*domain.Product{gshock, songoku},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, products, err := s.db.GetProducts(context.Background(), domain.ProcessFilter(tt.filter))
+			s.Require().NoError(err)
+			s.Assert().Equal(tt.want, products)
+		})
+	}
+}
+
+func (s *DatabaseTestSuite) TestSearchProducts() {
+	filter := domain.ProcessFilter(domain.Filter{})
+
+	n, products, err := s.db.SearchProducts(context.Background(), "shoc", filter)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+	s.Assert().Equal([]*domain.Product{s.domainProducts[1]}, products)
+
+	n, products, err = s.db.SearchProducts(context.Background(), "SONG", filter)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+	s.Assert().Equal([]*domain.Product{s.domainProducts[0]}, products)
+
+	n, _, err = s.db.SearchProducts(context.Background(), "song shock", filter)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(0), n)
+}
+
+func (s *DatabaseTestSuite) TestGetProducts_Keyset() {
+	ctx := context.Background()
+	songoku, gshock := s.domainProducts[0], s.domainProducts[1]
+
+	for _, sortBy := range []string{"", domain.SortByName, domain.SortByPrice, domain.SortByStock, domain.SortByCreatedAt} {
+		s.Run("sort by "+sortBy, func() {
+			filter := domain.ProcessFilter(domain.Filter{
+				PageSize:  1,
+				Keyset:    true,
+				SkipCount: true,
+				SortBy:    sortBy,
+			})
+
+			_, first, err := s.db.GetProducts(ctx, filter)
+			s.Require().NoError(err)
+			s.Require().Len(first, 1)
+
+			next := domain.ProductCursor(first[0], filter, false)
+			filter.Position = &next
+			_, second, err := s.db.GetProducts(ctx, filter)
+			s.Require().NoError(err)
+			s.Require().Len(second, 1)
+			s.Assert().ElementsMatch([]*domain.Product{songoku, gshock}, []*domain.Product{first[0], second[0]})
+
+			prev := domain.ProductCursor(second[0], filter, true)
+			filter.Position = &prev
+			_, back, err := s.db.GetProducts(ctx, filter)
+			s.Require().NoError(err)
+			s.Assert().Equal(first, back)
+		})
+	}
+}
+
+func (s *DatabaseTestSuite) TestGetProductsByIDs() {
+	ctx := context.Background()
+
+	got, err := s.db.GetProductsByIDs(ctx, []int64{s.products[1].ID, -1, s.products[0].ID})
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch(s.domainProducts, got)
+}
+
+func (s *DatabaseTestSuite) TestProductIdentifiers() {
+	ctx := context.Background()
+
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		SKU:          "DB-VEGETA",
+		Barcode:      "4006381333931",
+		Name:         "Vegeta",
+		SubCategory:  "Toys & Games",
+		ActualPrice:  400000,
+		CurrencyCode: "VND",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		SKU:          "DB-VEGETA",
+		Name:         "Vegeta 2",
+		SubCategory:  "Toys & Games",
+		ActualPrice:  400000,
+		CurrencyCode: "VND",
+	})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+
+	bySKU, err := s.db.GetProductBySKU(ctx, "DB-VEGETA")
+	s.Require().NoError(err)
+	s.Assert().Equal(id, bySKU.ID)
+	s.Assert().Equal("4006381333931", bySKU.Barcode)
+
+	byBarcode, err := s.db.GetProductByBarcode(ctx, "4006381333931")
+	s.Require().NoError(err)
+	s.Assert().Equal(bySKU, byBarcode)
+
+	_, err = s.db.GetProductBySKU(ctx, "DB-GOHAN")
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	takenSKUs, takenBarcodes, err := s.db.FindTakenIdentifiers(ctx, []string{"DB-VEGETA", "DB-GOHAN"}, []string{"4006381333931"})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"DB-VEGETA"}, takenSKUs)
+	s.Assert().Equal([]string{"4006381333931"}, takenBarcodes)
+
+	db := s.getGormDB()
+	err = db.Unscoped().Delete(&Product{}, id).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestVariants() {
+	ctx := context.Background()
+
+	productID, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "DB Shirt",
+		SubCategory:  "Toys & Games",
+		ActualPrice:  200000,
+		CurrencyCode: "VND",
+	})
+	s.Require().NoError(err)
+
+	price := 250000.0
+	small, err := s.db.CreateVariant(ctx, &domain.CreateVariantRequest{
+		ProductID:   productID,
+		SKU:         "DB-SHIRT-S",
+		Options:     map[string]string{"size": "S", "colour": "red"},
+		StockNumber: 4,
+	})
+	s.Require().NoError(err)
+	_, err = s.db.CreateVariant(ctx, &domain.CreateVariantRequest{
+		ProductID:   productID,
+		Options:     map[string]string{"size": "XL", "colour": "red"},
+		StockNumber: 2,
+		ActualPrice: &price,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.db.CreateVariant(ctx, &domain.CreateVariantRequest{
+		ProductID: productID,
+		Options:   map[string]string{"colour": "red", "size": "S"},
+	})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+	_, err = s.db.CreateVariant(ctx, &domain.CreateVariantRequest{
+		ProductID: 99999,
+		Options:   map[string]string{"size": "S"},
+	})
+	if !errors.Is(err, domain.ErrAssociationNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAssociationNotFound)
+	}
+
+	product, err := s.db.GetProductByID(ctx, productID)
+	s.Require().NoError(err)
+	s.Require().Len(product.Variants, 2)
+	s.Assert().Equal(6, product.VariantStockNumber)
+	s.Assert().Equal("DB-SHIRT-S", product.Variants[0].SKU)
+	s.Assert().Nil(product.Variants[0].ActualPrice)
+	s.Require().NotNil(product.Variants[1].ActualPrice)
+	s.Assert().Equal(price, *product.Variants[1].ActualPrice)
+
+	err = s.db.UpdateVariant(ctx, &domain.UpdateVariantRequest{
+		ID:          small,
+		Options:     map[string]string{"size": "S", "colour": "blue"},
+		StockNumber: 10,
+		Version:     1,
+	})
+	s.Require().NoError(err)
+	err = s.db.DeleteVariant(ctx, &domain.DeleteVariantRequest{ID: small, Version: 1})
+	if !errors.Is(err, domain.ErrEditConflict) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrEditConflict)
+	}
+
+	variants, err := s.db.GetVariants(ctx, productID)
+	s.Require().NoError(err)
+	s.Require().Len(variants, 2)
+	s.Assert().Equal("", variants[0].SKU)
+	s.Assert().Equal("blue", variants[0].Options["colour"])
+	s.Assert().Equal(int64(2), variants[0].Version)
+
+	err = s.db.DeleteVariant(ctx, &domain.DeleteVariantRequest{ID: small, Version: 2})
+	s.Require().NoError(err)
+
+	_, movements, err := s.db.GetStockMovements(ctx, productID, domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Require().Len(movements, 4)
+	for i, want := range []struct {
+		variantID  int64
+		reason     domain.StockMovementReason
+		quantity   int
+		stockAfter int
+	}{
+		{small, domain.MovementAdjustment, -10, 0},
+		{small, domain.MovementAdjustment, 6, 10},
+		{variants[1].ID, domain.MovementReceipt, 2, 2},
+		{small, domain.MovementReceipt, 4, 4},
+	} {
+		s.Assert().Equal(want.variantID, movements[i].VariantID)
+		s.Assert().Equal(want.reason, movements[i].Reason)
+		s.Assert().Equal(want.quantity, movements[i].Quantity)
+		s.Assert().Equal(want.stockAfter, movements[i].StockAfter)
+	}
+
+	history, err := s.db.GetProductHistory(ctx, productID)
+	s.Require().NoError(err)
+	s.Assert().Len(history, 5)
+
+	_, err = s.db.GetVariants(ctx, 99999)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	db := s.getGormDB()
+	err = db.Unscoped().Delete(&Product{}, productID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestProductHistory() {
+	ctx := domain.ContextWithActor(context.Background(), "alice")
+
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "DB Krillin",
+		SubCategory:  "Toys & Games",
+		StockNumber:  5,
+		ActualPrice:  300000,
+		CurrencyCode: "VND",
+	})
+	s.Require().NoError(err)
+	created := time.Now()
+
+	// Stock changes take no snapshot, the ledger has them.
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: id, Delta: -2, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+	adjusted := time.Now()
+
+	err = s.db.UpdateProduct(context.Background(), &domain.UpdateProductRequest{
+		ID:           id,
+		Name:         "DB Krillin v2",
+		SubCategory:  "Toys & Games",
+		ActualPrice:  350000,
+		CurrencyCode: "VND",
+		Version:      2,
+	})
+	s.Require().NoError(err)
+	err = s.db.DeleteProduct(ctx, &domain.DeleteProductRequest{ID: id, Version: 3})
+	s.Require().NoError(err)
+
+	history, err := s.db.GetProductHistory(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Assert().Equal(domain.ProductCreated, history[0].Change)
+	s.Assert().Equal("alice", history[0].Actor)
+	s.Assert().Equal("DB Krillin", history[0].Product.Name)
+	s.Assert().Equal(domain.ProductUpdated, history[1].Change)
+	s.Assert().Equal(domain.SystemActor, history[1].Actor)
+	s.Assert().Equal(int64(3), history[1].Version)
+	s.Assert().Equal(350000.0, history[1].Product.ActualPrice)
+	s.Assert().Equal(domain.ProductDeleted, history[2].Change)
+	s.Assert().NotNil(history[2].Product.DeletedAt)
+
+	snapshot, err := s.db.GetProductSnapshot(ctx, id, created)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), snapshot.Version)
+	s.Assert().Equal(5, snapshot.Product.StockNumber)
+
+	snapshot, err = s.db.GetProductSnapshot(ctx, id, adjusted)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), snapshot.Version)
+	s.Assert().Equal(3, snapshot.Product.StockNumber)
+
+	_, err = s.db.GetProductSnapshot(ctx, id, created.Add(-time.Hour))
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	db := s.getGormDB()
+	err = db.Where("product_id = ?", id).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", id).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, id).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestRelayEvents() {
+	ctx := context.Background()
+
+	publishAll := func() []*domain.Event {
+		var published []*domain.Event
+		_, err := s.db.RelayEvents(ctx, 1000, func(events []*domain.Event) error {
+			published = append(published, events...)
+			return nil
+		})
+		s.Require().NoError(err)
+		return published
+	}
+	publishAll()
+
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "DB Piccolo",
+		SubCategory:  "Toys & Games",
+		StockNumber:  2,
+		ActualPrice:  300000,
+		CurrencyCode: "VND",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.db.RelayEvents(ctx, 1000, func([]*domain.Event) error {
+		return errors.New("broker down")
+	})
+	s.Require().Error(err)
+
+	events := publishAll()
+	s.Require().Len(events, 2)
+	s.Assert().Equal(domain.EventProductCreated, events[0].Type)
+	s.Assert().Equal(domain.EventStockChanged, events[1].Type)
+	var product domain.Product
+	err = json.Unmarshal(events[0].Payload, &product)
+	s.Require().NoError(err)
+	s.Assert().Equal(id, product.ID)
+	s.Assert().Equal("DB Piccolo", product.Name)
+
+	s.Assert().Empty(publishAll())
+
+	db := s.getGormDB()
+	err = db.Where("product_id = ?", id).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", id).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, id).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestPurgeOutboxEvents() {
+	ctx := context.Background()
+	db := s.getGormDB()
+
+	old := time.Now().Add(-48 * time.Hour)
+	published := OutboxEvent{Type: string(domain.EventProductCreated), Payload: "{}", CreatedAt: old, PublishedAt: &old}
+	pending := OutboxEvent{Type: string(domain.EventProductCreated), Payload: "{}", CreatedAt: old}
+	recent := OutboxEvent{Type: string(domain.EventProductCreated), Payload: "{}", PublishedAt: &old}
+	unnotified := OutboxEvent{Type: string(domain.EventStockLow), Payload: "{}", CreatedAt: old, PublishedAt: &old}
+	for _, e := range []*OutboxEvent{&published, &pending, &recent, &unnotified} {
+		s.Require().NoError(db.Create(e).Error)
+	}
+
+	before := time.Now().Add(-24 * time.Hour)
+	n, err := s.db.PurgeOutboxEvents(ctx, before, false, false, 100)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+
+	var ids []int64
+	err = db.Model(&OutboxEvent{}).Where("id IN ?", []int64{published.ID, pending.ID, recent.ID, unnotified.ID}).
+		Order("id").Pluck("id", &ids).Error
+	s.Require().NoError(err)
+	s.Assert().Equal([]int64{pending.ID, recent.ID, unnotified.ID}, ids)
+
+	n, err = s.db.PurgeOutboxEvents(ctx, before, false, true, 100)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+
+	n, err = s.db.PurgeOutboxEvents(ctx, before, true, true, 100)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+
+	err = db.Where("id = ?", recent.ID).Delete(&OutboxEvent{}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestListenProductChanges() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan *domain.ProductChangeEvent, 100)
+	done := make(chan error)
+	go func() {
+		done <- s.db.ListenProductChanges(ctx, func(e *domain.ProductChangeEvent) {
+			events <- e
+		})
+	}()
+
+	// The listener may not be listening yet, so products are created until
+	// a change comes through.
+	var ids []int64
+	var got *domain.ProductChangeEvent
+	for got == nil && len(ids) < 50 {
+		id, err := s.db.CreateProduct(context.Background(), &domain.CreateProductRequest{
+			Name:         "DB Gohan",
+			SubCategory:  "Toys & Games",
+			ActualPrice:  300000,
+			CurrencyCode: "VND",
+		})
+		s.Require().NoError(err)
+		ids = append(ids, id)
+
+		select {
+		case got = <-events:
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	s.Require().NotNil(got)
+	s.Assert().Contains(ids, got.ProductID)
+	s.Assert().Equal(domain.EventProductCreated, got.Type)
+	s.Assert().Equal("Toys & Games", got.SubCategory)
+
+	cancel()
+	s.Assert().NoError(<-done)
+
+	db := s.getGormDB()
+	err := db.Where("product_id IN ?", ids).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, ids).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestLowStock() {
+	ctx := context.Background()
+
+	lowStockEvents := func() []*domain.Event {
+		var low []*domain.Event
+		_, err := s.db.RelayEvents(ctx, 1000, func(events []*domain.Event) error {
+			for _, e := range events {
+				if e.Type == domain.EventStockLow {
+					low = append(low, e)
+				}
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+		return low
+	}
+	lowStockEvents()
+
+	reorderPoint := 5
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:            "DB Trunks",
+		SubCategory:     "Toys & Games",
+		StockNumber:     10,
+		ActualPrice:     300000,
+		CurrencyCode:    "VND",
+		ReorderPoint:    &reorderPoint,
+		ReorderQuantity: 20,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: id, Delta: -4, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+	s.Assert().Empty(lowStockEvents())
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: id, Delta: -1, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: id, Delta: -1, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+
+	events := lowStockEvents()
+	s.Require().Len(events, 1)
+	var alert domain.LowStockAlert
+	err = json.Unmarshal(events[0].Payload, &alert)
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.LowStockAlert{
+		ProductID:       id,
+		Name:            "DB Trunks",
+		StockNumber:     5,
+		ReorderPoint:    5,
+		ReorderQuantity: 20,
+	}, alert)
+
+	n, products, err := s.db.GetLowStockProducts(ctx, domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+	s.Require().Len(products, 1)
+	s.Assert().Equal(id, products[0].ID)
+	s.Require().NotNil(products[0].ReorderPoint)
+	s.Assert().Equal(5, *products[0].ReorderPoint)
+
+	product, err := s.db.GetProductByID(ctx, id)
+	s.Require().NoError(err)
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: id, Delta: 10, Reason: domain.MovementReceipt})
+	s.Require().NoError(err)
+	err = s.db.UpdateProduct(ctx, &domain.UpdateProductRequest{
+		ID:              id,
+		Name:            product.Name,
+		SubCategory:     product.SubCategory,
+		StockNumber:     2,
+		ActualPrice:     product.ActualPrice,
+		CurrencyCode:    product.CurrencyCode,
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		Version:         product.Version + 1,
+	})
+	s.Require().NoError(err)
+	s.Assert().Len(lowStockEvents(), 1)
+
+	// The notifier is handed the alerts apart from the publisher, once.
+	notified := func() []*domain.Event {
+		var alerts []*domain.Event
+		_, err := s.db.NotifyEvents(ctx, 1000, func(events []*domain.Event) error {
+			for _, e := range events {
+				s.Assert().Equal(domain.EventStockLow, e.Type)
+				if e.ProductID == id {
+					alerts = append(alerts, e)
+				}
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+		return alerts
+	}
+	s.Assert().Len(notified(), 2)
+	s.Assert().Empty(notified())
+
+	// A nil reorder point clears it.
+	err = s.db.UpdateProduct(ctx, &domain.UpdateProductRequest{
+		ID:           id,
+		ActualPrice:  product.ActualPrice,
+		CurrencyCode: product.CurrencyCode,
+		Version:      product.Version + 2,
+	})
+	s.Require().NoError(err)
+	product, err = s.db.GetProductByID(ctx, id)
+	s.Require().NoError(err)
+	s.Assert().Nil(product.ReorderPoint)
+	s.Assert().Zero(product.ReorderQuantity)
+	n, _, err = s.db.GetLowStockProducts(ctx, domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Assert().Zero(n)
+
+	db := s.getGormDB()
+	err = db.Where("product_id = ?", id).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", id).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, id).Error
+	s.Require().NoError(err)
+}
+
 func (s *DatabaseTestSuite) TestCreateProduct() {
 	createRequest := &domain.CreateProductRequest{
 		Name:          "Superman",
@@ -108,141 +683,1348 @@ func (s *DatabaseTestSuite) TestCreateProduct() {
 	}
 
 	ctx := context.Background()
-	id, err := s.db.CreateProduct(ctx, createRequest)
+	id, err := s.db.CreateProduct(ctx, createRequest)
+	s.Require().NoError(err)
+
+	db := s.getGormDB()
+
+	var gotProduct Product
+	err = db.Preload(clause.Associations).First(&gotProduct, id).Error
+	s.Require().NoError(err)
+	s.Assert().NotNil(gotProduct)
+	s.Assert().Equal(createRequest.Name, gotProduct.Name)
+	s.Assert().Equal(createRequest.SubCategory, gotProduct.SubCategory.Name)
+	s.Assert().Equal(createRequest.CurrencyCode, gotProduct.Currency.Code)
+	s.Assert().Equal(createRequest.StockNumber, gotProduct.StockNumber)
+	s.Assert().Equal(createRequest.Image, gotProduct.Image)
+	s.Assert().Equal(createRequest.DiscountPrice, gotProduct.DiscountPrice)
+	s.Assert().Equal(createRequest.ActualPrice, gotProduct.ActualPrice)
+	s.Assert().Equal(int64(1), gotProduct.Version)
+
+	err = db.Delete(&gotProduct).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestUpdateProduct() {
+	p := Product{
+		Name:          "Superman",
+		SubCategory:   s.products[0].SubCategory,
+		Currency:      s.products[0].Currency,
+		StockNumber:   100,
+		Image:         "image.com/123",
+		DiscountPrice: 0,
+		ActualPrice:   10,
+		Version:       1,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+	s.Assert().NotEmpty(p.ID)
+
+	updateRequest := &domain.UpdateProductRequest{
+		ID:            p.ID,
+		Name:          "Fake Superman",
+		SubCategory:   s.products[1].SubCategory.Name,
+		CurrencyCode:  s.products[1].Currency.Code,
+		StockNumber:   10,
+		Image:         "image.com/456",
+		DiscountPrice: 10,
+		ActualPrice:   100,
+		Version:       1,
+	}
+
+	err = s.db.UpdateProduct(context.Background(), updateRequest)
+	s.Require().NoError(err)
+
+	gotProduct := &Product{}
+	err = db.Preload(clause.Associations).First(&gotProduct, p.ID).Error
+	s.Require().NoError(err)
+
+	s.Assert().Equal(updateRequest.Name, gotProduct.Name)
+	s.Assert().Equal(updateRequest.SubCategory, gotProduct.SubCategory.Name)
+	s.Assert().Equal(updateRequest.CurrencyCode, gotProduct.Currency.Code)
+	s.Assert().Equal(updateRequest.StockNumber, gotProduct.StockNumber)
+	s.Assert().Equal(updateRequest.Image, gotProduct.Image)
+	s.Assert().Equal(updateRequest.DiscountPrice, gotProduct.DiscountPrice)
+	s.Assert().Equal(updateRequest.ActualPrice, gotProduct.ActualPrice)
+	s.Assert().Equal(updateRequest.Version+1, gotProduct.Version)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestUpdateProduct_Conflict() {
+	p := Product{
+		Name:          "Superman",
+		SubCategory:   s.products[0].SubCategory,
+		Currency:      s.products[0].Currency,
+		StockNumber:   100,
+		Image:         "image.com/123",
+		DiscountPrice: 0,
+		ActualPrice:   10,
+		Version:       2,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+	s.Assert().NotEmpty(p.ID)
+
+	updateRequest := &domain.UpdateProductRequest{
+		ID:            p.ID,
+		Name:          "Fake Superman",
+		SubCategory:   s.products[1].SubCategory.Name,
+		CurrencyCode:  s.products[1].Currency.Code,
+		StockNumber:   10,
+		Image:         "image.com/456",
+		DiscountPrice: 10,
+		ActualPrice:   100,
+		Version:       1,
+	}
+
+	err = s.db.UpdateProduct(context.Background(), updateRequest)
+	s.Require().Error(err)
+	if !errors.Is(err, domain.ErrEditConflict) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrEditConflict)
+	}
+
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestDeleteProduct() {
+	p := Product{
+		Name:          "Superman",
+		SubCategory:   s.products[0].SubCategory,
+		Currency:      s.products[0].Currency,
+		StockNumber:   100,
+		Image:         "image.com/123",
+		DiscountPrice: 0,
+		ActualPrice:   10,
+		Version:       1,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+	s.Assert().NotEmpty(p.ID)
+
+	req := &domain.DeleteProductRequest{
+		ID:      p.ID,
+		Version: p.Version,
+	}
+
+	ctx := context.Background()
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{
+		ProductID: p.ID,
+		OrderID:   "order-delete",
+		Quantity:  1,
+		TTL:       domain.DefaultReservationTTL,
+	})
+	s.Require().NoError(err)
+
+	err = s.db.DeleteProduct(ctx, &domain.DeleteProductRequest{ID: p.ID, Version: 2})
+	if !errors.Is(err, domain.ErrInUse) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInUse)
+	}
+
+	err = s.db.ReleaseReservation(ctx, "order-delete")
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&Reservation{}).Error
+	s.Require().NoError(err)
+
+	req.Version = 3
+	err = s.db.DeleteProduct(ctx, req)
+	s.Require().NoError(err)
+
+	_, err = s.db.GetProductByID(ctx, p.ID)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	n, deleted, err := s.db.GetDeletedProducts(ctx, domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+	s.Require().Len(deleted, 1)
+	s.Assert().Equal(p.ID, deleted[0].ID)
+	s.Assert().NotNil(deleted[0].DeletedAt)
+
+	err = s.db.RestoreProduct(ctx, p.ID)
+	s.Require().NoError(err)
+	err = s.db.RestoreProduct(ctx, p.ID)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	restored, err := s.db.GetProductByID(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Nil(restored.DeletedAt)
+	s.Assert().Equal(int64(4), restored.Version)
+
+	err = s.db.DeleteProduct(ctx, &domain.DeleteProductRequest{ID: p.ID, Version: restored.Version})
+	s.Require().NoError(err)
+
+	purged, err := s.db.PurgeDeletedProducts(ctx, time.Now().Add(-time.Hour), 10)
+	s.Require().NoError(err)
+	s.Assert().Zero(purged)
+
+	purged, err = s.db.PurgeDeletedProducts(ctx, time.Now(), 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), purged)
+
+	err = db.Unscoped().First(&Product{}, p.ID).Error
+	s.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	n, _, err = s.db.GetStockMovements(ctx, p.ID, domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(2), n)
+
+	err = db.Where("product_id = ?", p.ID).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestAdjustStock() {
+	p := Product{
+		Name:        "Superman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		StockNumber: 5,
+		ActualPrice: 10,
+		Version:     1,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+
+	ctx := context.Background()
+	level, err := s.db.AdjustStock(ctx, &domain.AdjustStockRequest{
+		ProductID: p.ID,
+		Delta:     -5,
+		Reason:    "sale",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&domain.StockLevel{ProductID: p.ID, StockNumber: 0, Version: 2}, level)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{
+		ProductID: p.ID,
+		Delta:     -1,
+		Reason:    "sale",
+	})
+	if !errors.Is(err, domain.ErrInsufficientStock) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInsufficientStock)
+	}
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{
+		ProductID: p.ID + 1000,
+		Delta:     1,
+		Reason:    "receipt",
+	})
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestGetStockMovements() {
+	ctx := context.Background()
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "Superman",
+		SubCategory:  s.products[0].SubCategory.Name,
+		StockNumber:  10,
+		ActualPrice:  10,
+		CurrencyCode: s.products[0].Currency.Code,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{
+		ProductID: id,
+		Delta:     -3,
+		Reason:    domain.MovementSale,
+	})
+	s.Require().NoError(err)
+
+	n, movements, err := s.db.GetStockMovements(ctx, id, domain.Filter{
+		Page:     1,
+		PageSize: domain.DefaultPageSize,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(2), n)
+	s.Require().Len(movements, 2)
+	s.Assert().Equal(domain.MovementSale, movements[0].Reason)
+	s.Assert().Equal(-3, movements[0].Quantity)
+	s.Assert().Equal(7, movements[0].StockAfter)
+	s.Assert().Equal(domain.MovementReceipt, movements[1].Reason)
+	s.Assert().Equal(10, movements[1].Quantity)
+
+	db := s.getGormDB()
+	err = db.Unscoped().Delete(&Product{}, id).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestReserveStock() {
+	p := Product{
+		Name:        "Superman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		StockNumber: 5,
+		ActualPrice: 10,
+		Version:     1,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+
+	ctx := context.Background()
+	reservation, err := s.db.ReserveStock(ctx, &domain.ReserveStockRequest{
+		ProductID: p.ID,
+		OrderID:   "order-1",
+		Quantity:  5,
+		TTL:       domain.DefaultReservationTTL,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.ReservationHeld, reservation.Status)
+
+	gotProduct, err := s.db.GetProductByID(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(0, gotProduct.StockNumber)
+	s.Assert().Equal(int64(2), gotProduct.Version)
+
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{
+		ProductID: p.ID,
+		OrderID:   "order-2",
+		Quantity:  1,
+		TTL:       domain.DefaultReservationTTL,
+	})
+	if !errors.Is(err, domain.ErrInsufficientStock) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInsufficientStock)
+	}
+
+	err = s.db.ReleaseReservation(ctx, "order-1")
+	s.Require().NoError(err)
+
+	gotProduct, err = s.db.GetProductByID(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(5, gotProduct.StockNumber)
+
+	err = s.db.ConfirmReservation(ctx, "order-1")
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	err = db.Where("product_id = ?", p.ID).Delete(&Reservation{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestReleaseExpiredReservations() {
+	p := Product{
+		Name:        "Superman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		StockNumber: 5,
+		ActualPrice: 10,
+		Version:     1,
+	}
+
+	db := s.getGormDB()
+
+	err := db.Save(&p).Error
+	s.Require().NoError(err)
+
+	ctx := context.Background()
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{
+		ProductID: p.ID,
+		OrderID:   "order-expired",
+		Quantity:  2,
+		TTL:       -time.Minute,
+	})
+	s.Require().NoError(err)
+
+	n, err := s.db.ReleaseExpiredReservations(ctx, time.Now(), 10)
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), n)
+
+	reservations, err := s.db.GetReservations(ctx, "order-expired")
+	s.Require().NoError(err)
+	s.Require().Len(reservations, 1)
+	s.Assert().Equal(domain.ReservationExpired, reservations[0].Status)
+
+	gotProduct, err := s.db.GetProductByID(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(5, gotProduct.StockNumber)
+
+	err = db.Where("product_id = ?", p.ID).Delete(&Reservation{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestStockTransfer() {
+	ctx := context.Background()
+	fromID, err := s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "FROM", Name: "Source"})
+	s.Require().NoError(err)
+	toID, err := s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "TO", Name: "Destination"})
+	s.Require().NoError(err)
+
+	p := Product{
+		Name:        "Superman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		ActualPrice: 10,
+		Version:     1,
+	}
+
+	db := s.getGormDB()
+
+	err = db.Save(&p).Error
+	s.Require().NoError(err)
+
+	_, err = s.db.AdjustWarehouseStock(ctx, &domain.AdjustWarehouseStockRequest{
+		ProductID:   p.ID,
+		WarehouseID: fromID,
+		Delta:       5,
+		Reason:      domain.MovementReceipt,
+	})
+	s.Require().NoError(err)
+
+	id, err := s.db.CreateStockTransfer(ctx, &domain.CreateStockTransferRequest{
+		ProductID:       p.ID,
+		FromWarehouseID: fromID,
+		ToWarehouseID:   toID,
+		Quantity:        2,
+	})
+	s.Require().NoError(err)
+
+	err = s.db.ReceiveStockTransfer(ctx, id)
+	if !errors.Is(err, domain.ErrEditConflict) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrEditConflict)
+	}
+
+	err = s.db.DispatchStockTransfer(ctx, id)
+	s.Require().NoError(err)
+
+	productStock, err := s.db.GetProductStock(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(3, productStock.StockNumber)
+	s.Assert().Equal(3, productStock.WarehouseStockNumber)
+
+	err = s.db.ReceiveStockTransfer(ctx, id)
+	s.Require().NoError(err)
+
+	transfer, err := s.db.GetStockTransfer(ctx, id)
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.TransferReceived, transfer.Status)
+
+	productStock, err = s.db.GetProductStock(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(5, productStock.StockNumber)
+	s.Require().Len(productStock.Warehouses, 2)
+	s.Assert().Equal(3, productStock.Warehouses[0].StockNumber)
+	s.Assert().Equal(2, productStock.Warehouses[1].StockNumber)
+
+	err = db.Delete(&StockTransfer{}, id).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&WarehouseStock{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+	err = db.Delete(&Warehouse{}, []int64{fromID, toID}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestWarehouseStock() {
+	ctx := context.Background()
+	hanoiID, err := s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "HN", Name: "Ha Noi"})
+	s.Require().NoError(err)
+	saigonID, err := s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "SG", Name: "Sai Gon"})
+	s.Require().NoError(err)
+
+	_, err = s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "HN", Name: "Ha Noi 2"})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+
+	p := Product{
+		Name:        "Superman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		ActualPrice: 10,
+		Version:     1,
+	}
+
+	db := s.getGormDB()
+
+	err = db.Save(&p).Error
+	s.Require().NoError(err)
+
+	_, err = s.db.AdjustWarehouseStock(ctx, &domain.AdjustWarehouseStockRequest{
+		ProductID:   p.ID,
+		WarehouseID: hanoiID,
+		Delta:       5,
+		Reason:      domain.MovementReceipt,
+	})
+	s.Require().NoError(err)
+	stock, err := s.db.AdjustWarehouseStock(ctx, &domain.AdjustWarehouseStockRequest{
+		ProductID:   p.ID,
+		WarehouseID: saigonID,
+		Delta:       3,
+		Reason:      domain.MovementReceipt,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.WarehouseStock{
+		WarehouseID:   saigonID,
+		WarehouseCode: "SG",
+		WarehouseName: "Sai Gon",
+		StockNumber:   3,
+	}, *stock)
+
+	_, err = s.db.AdjustWarehouseStock(ctx, &domain.AdjustWarehouseStockRequest{
+		ProductID:   p.ID,
+		WarehouseID: saigonID,
+		Delta:       -4,
+		Reason:      domain.MovementSale,
+	})
+	if !errors.Is(err, domain.ErrInsufficientStock) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInsufficientStock)
+	}
+
+	productStock, err := s.db.GetProductStock(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(8, productStock.StockNumber)
+	s.Assert().Equal(8, productStock.WarehouseStockNumber)
+	s.Require().Len(productStock.Warehouses, 2)
+	s.Assert().Equal(5, productStock.Warehouses[0].StockNumber)
+	s.Assert().Equal(3, productStock.Warehouses[1].StockNumber)
+
+	gotProduct, err := s.db.GetProductByID(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(productStock.Warehouses, gotProduct.Warehouses)
+	s.Assert().Equal(8, gotProduct.WarehouseStockNumber)
+
+	discrepancies := func() []*domain.ProductStock {
+		_, stocks, err := s.db.GetStockDiscrepancies(ctx, domain.ProcessFilter(domain.Filter{PageSize: 100}))
+		s.Require().NoError(err)
+		i := slices.IndexFunc(stocks, func(stock *domain.ProductStock) bool { return stock.ProductID == p.ID })
+		if i == -1 {
+			return nil
+		}
+		return stocks[i : i+1]
+	}
+	s.Assert().Empty(discrepancies())
+
+	// A sale without a warehouse leaves the warehouse stocks as they were.
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: p.ID, Delta: -2, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+	s.Assert().Equal([]*domain.ProductStock{{
+		ProductID:            p.ID,
+		StockNumber:          6,
+		WarehouseStockNumber: 8,
+		Warehouses:           productStock.Warehouses,
+	}}, discrepancies())
+
+	err = db.Where("product_id = ?", p.ID).Delete(&WarehouseStock{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+	err = db.Delete(&Warehouse{}, []int64{hanoiID, saigonID}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestCategories() {
+	ctx := context.Background()
+
+	mcID, err := s.db.CreateMainCategory(ctx, &domain.CreateMainCategoryRequest{Name: "books"})
+	s.Require().NoError(err)
+	_, err = s.db.CreateMainCategory(ctx, &domain.CreateMainCategoryRequest{Name: "books"})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+
+	_, err = s.db.CreateSubCategory(ctx, &domain.CreateSubCategoryRequest{MainCategory: "music", Name: "Vinyl"})
+	if !errors.Is(err, domain.ErrAssociationNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAssociationNotFound)
+	}
+	comicsID, err := s.db.CreateSubCategory(ctx, &domain.CreateSubCategoryRequest{MainCategory: "books", Name: "Comics"})
+	s.Require().NoError(err)
+	mangaID, err := s.db.CreateSubCategory(ctx, &domain.CreateSubCategoryRequest{MainCategory: "books", Name: "Mangas"})
+	s.Require().NoError(err)
+
+	err = s.db.UpdateSubCategory(ctx, &domain.UpdateSubCategoryRequest{ID: mangaID, Name: "Manga"})
+	s.Require().NoError(err)
+	err = s.db.UpdateMainCategory(ctx, &domain.UpdateMainCategoryRequest{ID: mcID, Name: "books & comics"})
+	s.Require().NoError(err)
+
+	categories, err := s.db.GetCategories(ctx)
+	s.Require().NoError(err)
+	var books *domain.MainCategory
+	for _, c := range categories {
+		if c.ID == mcID {
+			books = c
+		}
+	}
+	s.Require().NotNil(books)
+	s.Assert().Equal(&domain.MainCategory{
+		ID:   mcID,
+		Name: "books & comics",
+		SubCategories: []*domain.SubCategory{
+			{ID: comicsID, MainCategory: "books & comics", Name: "Comics"},
+			{ID: mangaID, MainCategory: "books & comics", Name: "Manga"},
+		},
+	}, books)
+
+	id, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "Dragon Ball",
+		SubCategory:  "Manga",
+		ActualPrice:  10,
+		CurrencyCode: "USD",
+	})
+	s.Require().NoError(err)
+	deletedID, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "Naruto",
+		SubCategory:  "Manga",
+		ActualPrice:  10,
+		CurrencyCode: "USD",
+	})
+	s.Require().NoError(err)
+	err = s.db.DeleteProduct(ctx, &domain.DeleteProductRequest{ID: deletedID, Version: 1})
+	s.Require().NoError(err)
+
+	err = s.db.DeleteMainCategory(ctx, mcID)
+	if !errors.Is(err, domain.ErrInUse) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInUse)
+	}
+	err = s.db.DeleteSubCategory(ctx, &domain.DeleteSubCategoryRequest{ID: mangaID})
+	if !errors.Is(err, domain.ErrInUse) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrInUse)
+	}
+
+	err = s.db.DeleteSubCategory(ctx, &domain.DeleteSubCategoryRequest{ID: mangaID, ReassignTo: "Comics"})
+	s.Require().NoError(err)
+	product, err := s.db.GetProductByID(ctx, id)
+	s.Require().NoError(err)
+	s.Assert().Equal("Comics", product.SubCategory)
+	s.Assert().Equal(int64(2), product.Version)
+	history, err := s.db.GetProductHistory(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Assert().Equal(domain.ProductUpdated, history[1].Change)
+	s.Assert().Equal("Comics", history[1].Product.SubCategory)
+
+	db := s.getGormDB()
+	var deleted Product
+	err = db.Unscoped().First(&deleted, deletedID).Error
+	s.Require().NoError(err)
+	s.Assert().Equal(comicsID, deleted.SubCategoryID)
+	s.Assert().Equal(int64(2), deleted.Version)
+
+	err = db.Where("product_id IN ?", []int64{id, deletedID}).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, []int64{id, deletedID}).Error
+	s.Require().NoError(err)
+
+	err = s.db.DeleteSubCategory(ctx, &domain.DeleteSubCategoryRequest{ID: comicsID})
+	s.Require().NoError(err)
+	err = s.db.DeleteMainCategory(ctx, mcID)
+	s.Require().NoError(err)
+	err = s.db.DeleteMainCategory(ctx, mcID)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+}
+
+func (s *DatabaseTestSuite) TestCurrencies() {
+	ctx := context.Background()
+
+	_, err := s.db.CreateCurrency(ctx, &domain.CreateCurrencyRequest{Code: "AUD", Symbol: "$"})
+	s.Require().NoError(err)
+	_, err = s.db.CreateCurrency(ctx, &domain.CreateCurrencyRequest{Code: "AUD", Symbol: "A$"})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+
+	err = s.db.SetCurrencyDisabled(ctx, "AUD", true)
+	s.Require().NoError(err)
+	found, err := s.db.IsCurrencyCodeExists(ctx, "AUD")
+	s.Require().NoError(err)
+	s.Assert().False(found)
+
+	enabled, err := s.db.GetCurrencies(ctx, false)
+	s.Require().NoError(err)
+	all, err := s.db.GetCurrencies(ctx, true)
+	s.Require().NoError(err)
+	s.Assert().Len(all, len(enabled)+1)
+
+	err = s.db.SetCurrencyDisabled(ctx, "XXX", true)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, r := range []domain.SetExchangeRateRequest{
+		{BaseCurrency: "USD", QuoteCurrency: "AUD", Rate: 1.5, EffectiveDate: day},
+		{BaseCurrency: "USD", QuoteCurrency: "AUD", Rate: 1.6, EffectiveDate: day.AddDate(0, 0, 2)},
+		{BaseCurrency: "USD", QuoteCurrency: "AUD", Rate: 1.55, EffectiveDate: day},
+	} {
+		err = s.db.SetExchangeRate(ctx, &r)
+		s.Require().NoError(err)
+	}
+
+	rate, err := s.db.GetExchangeRate(ctx, "USD", "AUD", day.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+	s.Assert().Equal(1.55, rate.Rate)
+	s.Assert().True(day.Equal(rate.EffectiveDate))
+
+	rate, err = s.db.GetExchangeRate(ctx, "USD", "AUD", day.AddDate(0, 1, 0))
+	s.Require().NoError(err)
+	s.Assert().Equal(1.6, rate.Rate)
+
+	_, err = s.db.GetExchangeRate(ctx, "USD", "AUD", day.AddDate(0, 0, -1))
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+
+	db := s.getGormDB()
+	err = db.Where("1 = 1").Delete(&ExchangeRate{}).Error
+	s.Require().NoError(err)
+	err = db.Where("code = ?", "AUD").Delete(&Currency{}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestMigrationStatus() {
+	ctx := context.Background()
+	adapter := s.db.(*Adapter)
+
+	err := adapter.MigrateUp(ctx)
+	s.Require().NoError(err)
+
+	statuses, err := adapter.MigrationStatus(ctx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(statuses)
+	for _, st := range statuses {
+		s.Assert().True(st.Applied, "migration %d_%s is not applied", st.Version, st.Name)
+	}
+
+	err = adapter.MigrateTo(ctx, 99999)
+	if !errors.Is(err, ErrUnknownMigration) {
+		s.T().Errorf("got error %q, want %q", err, ErrUnknownMigration)
+	}
+}
+
+// The baseline* models are the models GORM AutoMigrate built the schema
+// from before versioned migrations.
+type baselineCurrency struct {
+	BaseModel
+	Code   string `gorm:"not null;uniqueIndex"`
+	Symbol string `gorm:"not null;uniqueIndex"`
+}
+
+func (baselineCurrency) TableName() string { return "currencies" }
+
+type baselineMainCategory struct {
+	BaseModel
+	Name string `gorm:"not null;uniqueIndex"`
+}
+
+func (baselineMainCategory) TableName() string { return "main_categories" }
+
+type baselineSubCategory struct {
+	BaseModel
+	Name           string `gorm:"not null;uniqueIndex"`
+	MainCategoryID int64  `gorm:"not null"`
+	MainCategory   baselineMainCategory
+}
+
+func (baselineSubCategory) TableName() string { return "sub_categories" }
+
+type baselineProduct struct {
+	BaseModel
+
+	Name        string `gorm:"not null"`
+	StockNumber int    `gorm:"type=integer;not null;check:stock_number > 0"`
+	Image       string
+
+	DiscountPrice float64 `gorm:"check:discount_price >= 0"`
+	ActualPrice   float64 `gorm:"not null;check:actual_price >= 0"`
+
+	SubCategoryID int64 `gorm:"not null"`
+	SubCategory   baselineSubCategory
+
+	CurrencyID int64 `gorm:"not null"`
+	Currency   baselineCurrency
+
+	Version int64 `gorm:"not null;default:1"`
+}
+
+func (baselineProduct) TableName() string { return "products" }
+
+func (s *DatabaseTestSuite) TestMigrateUpAdoptsAutoMigratedSchema() {
+	ctx := context.Background()
+	db := s.getGormDB()
+
+	err := db.Exec("CREATE DATABASE adopted").Error
+	s.Require().NoError(err)
+	defer func() {
+		err := db.Exec("DROP DATABASE adopted WITH (FORCE)").Error
+		s.Require().NoError(err)
+	}()
+
+	dsn := strings.Replace(s.dsn, "/ebisaan?", "/adopted?", 1)
+	adopted, err := gorm.Open(progresDriver.Open(dsn), &gorm.Config{})
+	s.Require().NoError(err)
+
+	err = adopted.AutoMigrate(&baselineCurrency{}, &baselineMainCategory{}, &baselineSubCategory{}, &baselineProduct{})
+	s.Require().NoError(err)
+
+	p := &baselineProduct{
+		Name:        "Songoku",
+		StockNumber: 1,
+		ActualPrice: 10,
+		SubCategory: baselineSubCategory{Name: "Toys & Games", MainCategory: baselineMainCategory{Name: "toys"}},
+		Currency:    baselineCurrency{Code: "VND", Symbol: "₫"},
+	}
+	err = adopted.Create(p).Error
+	s.Require().NoError(err)
+
+	adapter, err := NewAdapter(dsn)
+	s.Require().NoError(err)
+	err = adapter.MigrateUp(ctx)
+	s.Require().NoError(err)
+
+	found, err := adapter.IsCurrencyCodeExists(ctx, "VND")
+	s.Require().NoError(err)
+	s.Assert().True(found)
+
+	currencies, err := adapter.GetCurrencies(ctx, true)
+	s.Require().NoError(err)
+	s.Assert().Len(currencies, 1)
+
+	level, err := adapter.AdjustStock(ctx, &domain.AdjustStockRequest{
+		ProductID: p.ID,
+		Delta:     -1,
+		Reason:    domain.MovementSale,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(0, level.StockNumber)
+
+	_, err = adapter.CreateCurrency(ctx, &domain.CreateCurrencyRequest{Code: "AUD", Symbol: "$"})
+	s.Require().NoError(err)
+	_, err = adapter.CreateCurrency(ctx, &domain.CreateCurrencyRequest{Code: "USD", Symbol: "$"})
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestSeed() {
+	ctx := context.Background()
+	adapter := s.db.(*Adapter)
+
+	data := &SeedData{
+		Currencies: []SeedCurrency{{Code: "CHF", Symbol: "Fr."}},
+		Categories: []SeedCategory{
+			{Name: "garden", SubCategories: []string{"Plants", "Tools"}},
+		},
+	}
+	result, err := adapter.Seed(ctx, data)
+	s.Require().NoError(err)
+	s.Assert().Equal(SeedResult{Currencies: 1, MainCategories: 1, SubCategories: 2}, result)
+
+	data.Currencies[0].Symbol = "CHF"
+	data.Categories = append(data.Categories, SeedCategory{Name: "outdoor", SubCategories: []string{"Tools"}})
+	data.Categories[0].SubCategories = []string{"Plants"}
+	_, err = adapter.Seed(ctx, data)
+	s.Require().NoError(err)
+
+	currency, err := s.db.GetCurrency(ctx, "CHF")
+	s.Require().NoError(err)
+	s.Assert().Equal("CHF", currency.Symbol)
+
+	categories, err := s.db.GetCategories(ctx)
+	s.Require().NoError(err)
+	subCategories := make(map[string]string)
+	for _, c := range categories {
+		for _, sc := range c.SubCategories {
+			subCategories[sc.Name] = sc.MainCategory
+		}
+	}
+	s.Assert().Equal("garden", subCategories["Plants"])
+	s.Assert().Equal("outdoor", subCategories["Tools"])
+
+	_, err = adapter.Seed(ctx, &SeedData{Currencies: []SeedCurrency{{Code: "chf", Symbol: "Fr."}}})
+	s.Require().Error(err)
+
+	db := s.getGormDB()
+	err = db.Where("name IN ?", []string{"Plants", "Tools"}).Delete(&SubCategory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("name IN ?", []string{"garden", "outdoor"}).Delete(&MainCategory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("code = ?", "CHF").Delete(&Currency{}).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestCreateProducts() {
+	ctx := context.Background()
+
+	missing, err := s.db.FindMissingSubCategories(ctx, []string{"Toys & Games", "Clocks"})
 	s.Require().NoError(err)
+	s.Assert().Equal([]string{"Clocks"}, missing)
+	missing, err = s.db.FindMissingCurrencyCodes(ctx, []string{"VND", "XYZ"})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"XYZ"}, missing)
 
-	db := s.getGormDB()
+	_, err = s.db.CreateProducts(ctx, []*domain.CreateProductRequest{
+		{Name: "Casio", SubCategory: "Clocks", ActualPrice: 50, CurrencyCode: "USD"},
+	})
+	if !errors.Is(err, domain.ErrAssociationNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAssociationNotFound)
+	}
 
-	var gotProduct Product
-	err = db.Preload(clause.Associations).First(&gotProduct, id).Error
+	reorderPoint := 5
+	ids, err := s.db.CreateProducts(ctx, []*domain.CreateProductRequest{
+		{Name: "Vegeta", SubCategory: "Toys & Games", StockNumber: 3, ActualPrice: 400000, CurrencyCode: "VND"},
+		{Name: "Casio", SubCategory: "Watches", ActualPrice: 50, CurrencyCode: "USD"},
+		{Name: "Bulma", SubCategory: "Toys & Games", StockNumber: 2, ActualPrice: 400000, CurrencyCode: "VND", ReorderPoint: &reorderPoint},
+	})
 	s.Require().NoError(err)
-	s.Assert().NotNil(gotProduct)
-	s.Assert().Equal(createRequest.Name, gotProduct.Name)
-	s.Assert().Equal(createRequest.SubCategory, gotProduct.SubCategory.Name)
-	s.Assert().Equal(createRequest.CurrencyCode, gotProduct.Currency.Code)
-	s.Assert().Equal(createRequest.StockNumber, gotProduct.StockNumber)
-	s.Assert().Equal(createRequest.Image, gotProduct.Image)
-	s.Assert().Equal(createRequest.DiscountPrice, gotProduct.DiscountPrice)
-	s.Assert().Equal(createRequest.ActualPrice, gotProduct.ActualPrice)
-	s.Assert().Equal(int64(1), gotProduct.Version)
+	s.Require().Len(ids, 3)
 
-	err = db.Delete(&gotProduct).Error
+	vegeta, err := s.db.GetProductByID(ctx, ids[0])
+	s.Require().NoError(err)
+	s.Assert().Equal("Vegeta", vegeta.Name)
+	s.Assert().Equal(3, vegeta.StockNumber)
+	casio, err := s.db.GetProductByID(ctx, ids[1])
+	s.Require().NoError(err)
+	s.Assert().Equal("accessories", casio.MainCategory)
+
+	_, movements, err := s.db.GetStockMovements(ctx, ids[0], domain.ProcessFilter(domain.Filter{}))
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Assert().Equal(domain.MovementReceipt, movements[0].Reason)
+
+	db := s.getGormDB()
+	var alerts []OutboxEvent
+	err = db.Where("type = ? AND product_id IN ?", domain.EventStockLow, ids).Find(&alerts).Error
+	s.Require().NoError(err)
+	s.Require().Len(alerts, 1)
+	s.Assert().Equal(ids[2], alerts[0].ProductID)
+
+	err = db.Unscoped().Delete(&Product{}, ids).Error
 	s.Require().NoError(err)
 }
 
-func (s *DatabaseTestSuite) TestUpdateProduct() {
+func (s *DatabaseTestSuite) TestPurchaseOrder() {
+	ctx := context.Background()
+	warehouseID, err := s.db.CreateWarehouse(ctx, &domain.CreateWarehouseRequest{Code: "PO", Name: "Receiving"})
+	s.Require().NoError(err)
+	supplierID, err := s.db.CreateSupplier(ctx, &domain.CreateSupplierRequest{Name: "Acme", Email: "sales@acme.test"})
+	s.Require().NoError(err)
+
+	_, err = s.db.CreateSupplier(ctx, &domain.CreateSupplierRequest{Name: "Acme"})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
+	}
+
 	p := Product{
-		Name:          "Superman",
-		SubCategory:   s.products[0].SubCategory,
-		Currency:      s.products[0].Currency,
-		StockNumber:   100,
-		Image:         "image.com/123",
-		DiscountPrice: 0,
-		ActualPrice:   10,
-		Version:       1,
+		Name:        "Batman",
+		SubCategory: s.products[0].SubCategory,
+		Currency:    s.products[0].Currency,
+		ActualPrice: 10,
+		Version:     1,
 	}
 
 	db := s.getGormDB()
 
-	err := db.Save(&p).Error
+	err = db.Save(&p).Error
 	s.Require().NoError(err)
-	s.Assert().NotEmpty(p.ID)
 
-	updateRequest := &domain.UpdateProductRequest{
-		ID:            p.ID,
-		Name:          "Fake Superman",
-		SubCategory:   s.products[1].SubCategory.Name,
-		CurrencyCode:  s.products[1].Currency.Code,
-		StockNumber:   10,
-		Image:         "image.com/456",
-		DiscountPrice: 10,
-		ActualPrice:   100,
-		Version:       1,
+	_, err = s.db.CreatePurchaseOrder(ctx, &domain.CreatePurchaseOrderRequest{
+		SupplierID:   supplierID + 1000,
+		CurrencyCode: s.products[0].Currency.Code,
+		Lines:        []domain.CreatePurchaseOrderLine{{ProductID: p.ID, Quantity: 1, UnitCost: 1}},
+	})
+	if !errors.Is(err, domain.ErrAssociationNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAssociationNotFound)
 	}
 
-	err = s.db.UpdateProduct(context.Background(), updateRequest)
+	id, err := s.db.CreatePurchaseOrder(ctx, &domain.CreatePurchaseOrderRequest{
+		SupplierID:   supplierID,
+		CurrencyCode: s.products[0].Currency.Code,
+		Reference:    "PO-1",
+		Lines:        []domain.CreatePurchaseOrderLine{{ProductID: p.ID, Quantity: 5, UnitCost: 4}},
+	})
 	s.Require().NoError(err)
 
-	gotProduct := &Product{}
-	err = db.Preload(clause.Associations).First(&gotProduct, p.ID).Error
+	po, err := s.db.GetPurchaseOrder(ctx, id)
 	s.Require().NoError(err)
+	s.Assert().Equal(domain.PurchaseOrderOpen, po.Status)
+	s.Assert().Equal(s.products[0].Currency.Code, po.CurrencyCode)
+	s.Require().Len(po.Lines, 1)
+	lineID := po.Lines[0].ID
 
-	s.Assert().Equal(updateRequest.Name, gotProduct.Name)
-	s.Assert().Equal(updateRequest.SubCategory, gotProduct.SubCategory.Name)
-	s.Assert().Equal(updateRequest.CurrencyCode, gotProduct.Currency.Code)
-	s.Assert().Equal(updateRequest.StockNumber, gotProduct.StockNumber)
-	s.Assert().Equal(updateRequest.Image, gotProduct.Image)
-	s.Assert().Equal(updateRequest.DiscountPrice, gotProduct.DiscountPrice)
-	s.Assert().Equal(updateRequest.ActualPrice, gotProduct.ActualPrice)
-	s.Assert().Equal(updateRequest.Version+1, gotProduct.Version)
-	err = db.Delete(&Product{}, p.ID).Error
+	_, err = s.db.ReceiveGoods(ctx, &domain.ReceiveGoodsRequest{
+		PurchaseOrderID: id,
+		Lines:           []domain.ReceiveGoodsLine{{LineID: lineID, Quantity: 6}},
+	}, domain.CostFIFO)
+	if !errors.Is(err, domain.ErrOverReceipt) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrOverReceipt)
+	}
+
+	po, err = s.db.ReceiveGoods(ctx, &domain.ReceiveGoodsRequest{
+		PurchaseOrderID: id,
+		WarehouseID:     warehouseID,
+		Lines:           []domain.ReceiveGoodsLine{{LineID: lineID, Quantity: 2}},
+	}, domain.CostFIFO)
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.PurchaseOrderPartiallyReceived, po.Status)
+	s.Assert().Equal(2, po.Lines[0].ReceivedQuantity)
+
+	productStock, err := s.db.GetProductStock(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(2, productStock.StockNumber)
+	s.Assert().Equal(2, productStock.WarehouseStockNumber)
+
+	po, err = s.db.ReceiveGoods(ctx, &domain.ReceiveGoodsRequest{
+		PurchaseOrderID: id,
+		Lines:           []domain.ReceiveGoodsLine{{LineID: lineID, Quantity: 3}},
+	}, domain.CostFIFO)
+	s.Require().NoError(err)
+	s.Assert().Equal(domain.PurchaseOrderReceived, po.Status)
+	s.Assert().Equal(0, po.Lines[0].Remaining())
+
+	productStock, err = s.db.GetProductStock(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(5, productStock.StockNumber)
+
+	err = s.db.CancelPurchaseOrder(ctx, id)
+	if !errors.Is(err, domain.ErrEditConflict) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrEditConflict)
+	}
+
+	err = db.Delete(&PurchaseOrder{}, id).Error
+	s.Require().NoError(err)
+	err = db.Delete(&Supplier{}, supplierID).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&WarehouseStock{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
+	s.Require().NoError(err)
+	err = db.Delete(&Warehouse{}, warehouseID).Error
 	s.Require().NoError(err)
 }
 
-func (s *DatabaseTestSuite) TestUpdateProduct_Conflict() {
-	p := Product{
-		Name:          "Superman",
-		SubCategory:   s.products[0].SubCategory,
-		Currency:      s.products[0].Currency,
-		StockNumber:   100,
-		Image:         "image.com/123",
-		DiscountPrice: 0,
-		ActualPrice:   10,
-		Version:       2,
+func (s *DatabaseTestSuite) TestProductSuppliers() {
+	ctx := context.Background()
+	acmeID, err := s.db.CreateSupplier(ctx, &domain.CreateSupplierRequest{Name: "Acme"})
+	s.Require().NoError(err)
+	globexID, err := s.db.CreateSupplier(ctx, &domain.CreateSupplierRequest{Name: "Globex"})
+	s.Require().NoError(err)
+
+	err = s.db.UpdateSupplier(ctx, &domain.UpdateSupplierRequest{ID: globexID, Name: "Acme"})
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAlreadyExists)
 	}
+	err = s.db.UpdateSupplier(ctx, &domain.UpdateSupplierRequest{ID: globexID, Name: "Globex Corp", Email: "buy@globex.test"})
+	s.Require().NoError(err)
 
-	db := s.getGormDB()
+	suppliers, err := s.db.GetSuppliers(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(suppliers, 2)
+	s.Assert().Equal("Acme", suppliers[0].Name)
+	s.Assert().Equal("buy@globex.test", suppliers[1].Email)
 
-	err := db.Save(&p).Error
+	productID := s.products[0].ID
+	currency := s.products[0].Currency.Code
+	err = s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+		ProductID:    productID,
+		SupplierID:   acmeID,
+		SupplierSKU:  "A-1",
+		UnitCost:     3,
+		CurrencyCode: currency,
+		LeadTimeDays: 10,
+		Preferred:    true,
+	})
+	s.Require().NoError(err)
+	err = s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+		ProductID:    productID,
+		SupplierID:   globexID,
+		UnitCost:     2.5,
+		CurrencyCode: currency,
+		LeadTimeDays: 3,
+		Preferred:    true,
+	})
 	s.Require().NoError(err)
-	s.Assert().NotEmpty(p.ID)
 
-	updateRequest := &domain.UpdateProductRequest{
-		ID:            p.ID,
-		Name:          "Fake Superman",
-		SubCategory:   s.products[1].SubCategory.Name,
-		CurrencyCode:  s.products[1].Currency.Code,
-		StockNumber:   10,
-		Image:         "image.com/456",
-		DiscountPrice: 10,
-		ActualPrice:   100,
-		Version:       1,
+	// Concurrent calls each making a supplier preferred must not clash on
+	// the preferred index.
+	ids := []int64{acmeID, globexID, acmeID, globexID}
+	done := make(chan error, len(ids))
+	for _, id := range ids {
+		id := id
+		go func() {
+			done <- s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+				ProductID:    productID,
+				SupplierID:   id,
+				UnitCost:     3,
+				CurrencyCode: currency,
+				Preferred:    true,
+			})
+		}()
 	}
+	for range ids {
+		s.Require().NoError(<-done)
+	}
+	err = s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+		ProductID:    productID,
+		SupplierID:   acmeID,
+		SupplierSKU:  "A-1",
+		UnitCost:     3,
+		CurrencyCode: currency,
+		LeadTimeDays: 10,
+	})
+	s.Require().NoError(err)
+	err = s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+		ProductID:    productID,
+		SupplierID:   globexID,
+		UnitCost:     2.5,
+		CurrencyCode: currency,
+		LeadTimeDays: 3,
+		Preferred:    true,
+	})
+	s.Require().NoError(err)
 
-	err = s.db.UpdateProduct(context.Background(), updateRequest)
-	s.Require().Error(err)
-	if !errors.Is(err, domain.ErrEditConflict) {
-		s.T().Errorf("got error %q, want %q", err, domain.ErrEditConflict)
+	err = s.db.SetProductSupplier(ctx, &domain.SetProductSupplierRequest{
+		ProductID:    productID,
+		SupplierID:   globexID + 1000,
+		CurrencyCode: currency,
+	})
+	if !errors.Is(err, domain.ErrAssociationNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrAssociationNotFound)
+	}
+
+	links, err := s.db.GetProductSuppliers(ctx, productID)
+	s.Require().NoError(err)
+	s.Require().Len(links, 2)
+	s.Assert().Equal(globexID, links[0].SupplierID)
+	s.Assert().True(links[0].Preferred)
+	s.Assert().Equal("Globex Corp", links[0].SupplierName)
+	s.Assert().Equal(currency, links[0].CurrencyCode)
+	s.Assert().Equal(acmeID, links[1].SupplierID)
+	s.Assert().False(links[1].Preferred)
+	s.Assert().Equal("A-1", links[1].SupplierSKU)
+
+	err = s.db.RemoveProductSupplier(ctx, &domain.RemoveProductSupplierRequest{ProductID: productID, SupplierID: acmeID})
+	s.Require().NoError(err)
+	err = s.db.RemoveProductSupplier(ctx, &domain.RemoveProductSupplierRequest{ProductID: productID, SupplierID: acmeID})
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
 	}
 
-	err = db.Delete(&Product{}, p.ID).Error
+	err = s.db.DeleteSupplier(ctx, globexID)
+	s.Require().NoError(err)
+	links, err = s.db.GetProductSuppliers(ctx, productID)
+	s.Require().NoError(err)
+	s.Assert().Empty(links)
+
+	err = s.db.DeleteSupplier(ctx, acmeID)
 	s.Require().NoError(err)
+	err = s.db.DeleteSupplier(ctx, acmeID)
+	if !errors.Is(err, domain.ErrNotFound) {
+		s.T().Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
 }
 
-func (s *DatabaseTestSuite) TestDeleteProduct() {
-	p := Product{
-		Name:          "Superman",
-		SubCategory:   s.products[0].SubCategory,
-		Currency:      s.products[0].Currency,
-		StockNumber:   100,
-		Image:         "image.com/123",
-		DiscountPrice: 0,
-		ActualPrice:   10,
-		Version:       1,
+func (s *DatabaseTestSuite) TestCostLayers() {
+	ctx := context.Background()
+	supplierID, err := s.db.CreateSupplier(ctx, &domain.CreateSupplierRequest{Name: "Initech"})
+	s.Require().NoError(err)
+
+	db := s.getGormDB()
+	currency := s.products[0].Currency.Code
+
+	receive := func(productID int64, quantity int, unitCost float64, method domain.CostMethod) int64 {
+		id, err := s.db.CreatePurchaseOrder(ctx, &domain.CreatePurchaseOrderRequest{
+			SupplierID:   supplierID,
+			CurrencyCode: currency,
+			Lines:        []domain.CreatePurchaseOrderLine{{ProductID: productID, Quantity: quantity, UnitCost: unitCost}},
+		})
+		s.Require().NoError(err)
+		po, err := s.db.GetPurchaseOrder(ctx, id)
+		s.Require().NoError(err)
+		_, err = s.db.ReceiveGoods(ctx, &domain.ReceiveGoodsRequest{
+			PurchaseOrderID: id,
+			Lines:           []domain.ReceiveGoodsLine{{LineID: po.Lines[0].ID, Quantity: quantity}},
+		}, method)
+		s.Require().NoError(err)
+
+		return id
+	}
+
+	// The products get a subcategory of their own, so that the valuation
+	// line only counts them.
+	sub := SubCategory{Name: "Costed", MainCategoryID: s.products[0].SubCategory.MainCategoryID}
+	err = db.Create(&sub).Error
+	s.Require().NoError(err)
+
+	fifo := Product{Name: "Robin", SubCategory: sub, Currency: s.products[0].Currency, ActualPrice: 10, Version: 1}
+	err = db.Save(&fifo).Error
+	s.Require().NoError(err)
+	average := Product{Name: "Alfred", SubCategory: sub, Currency: s.products[0].Currency, ActualPrice: 10, Version: 1}
+	err = db.Save(&average).Error
+	s.Require().NoError(err)
+	opened, err := s.db.CreateProduct(ctx, &domain.CreateProductRequest{
+		Name:         "Nightwing",
+		SubCategory:  sub.Name,
+		StockNumber:  100,
+		ActualPrice:  10,
+		CurrencyCode: currency,
+	})
+	s.Require().NoError(err)
+
+	orderIDs := []int64{
+		receive(fifo.ID, 2, 4, domain.CostFIFO),
+		receive(fifo.ID, 3, 6, domain.CostFIFO),
+		receive(average.ID, 2, 4, domain.CostWeightedAverage),
+		receive(average.ID, 2, 6, domain.CostWeightedAverage),
+		receive(opened, 10, 7, domain.CostFIFO),
 	}
 
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: fifo.ID, Delta: -3, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+
+	layers, err := s.db.GetCostLayers(ctx, fifo.ID)
+	s.Require().NoError(err)
+	s.Require().Len(layers, 1)
+	s.Assert().Equal(2, layers[0].RemainingQuantity)
+	s.Assert().Equal(6.0, *layers[0].UnitCost)
+	s.Assert().Equal(currency, layers[0].CurrencyCode)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: fifo.ID, Delta: 1, Reason: domain.MovementReturn})
+	s.Require().NoError(err)
+
+	layers, err = s.db.GetCostLayers(ctx, fifo.ID)
+	s.Require().NoError(err)
+	s.Require().Len(layers, 2)
+	s.Assert().Equal(2, layers[0].RemainingQuantity)
+	s.Assert().Equal(1, layers[1].RemainingQuantity)
+	s.Assert().Nil(layers[1].UnitCost)
+
+	layers, err = s.db.GetCostLayers(ctx, average.ID)
+	s.Require().NoError(err)
+	s.Require().Len(layers, 1)
+	s.Assert().Equal(4, layers[0].RemainingQuantity)
+	s.Assert().Equal(5.0, *layers[0].UnitCost)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: average.ID, Delta: -1, Reason: domain.MovementDamage})
+	s.Require().NoError(err)
+
+	// The opening stock came in first, so it goes out first.
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: opened, Delta: -10, Reason: domain.MovementSale})
+	s.Require().NoError(err)
+
+	layers, err = s.db.GetCostLayers(ctx, opened)
+	s.Require().NoError(err)
+	s.Require().Len(layers, 2)
+	s.Assert().Nil(layers[0].UnitCost)
+	s.Assert().Equal(90, layers[0].RemainingQuantity)
+	s.Assert().Equal(10, layers[1].RemainingQuantity)
+	s.Assert().Equal(7.0, *layers[1].UnitCost)
+
+	valuation, err := s.db.GetValuation(ctx)
+	s.Require().NoError(err)
+	i := slices.IndexFunc(valuation, func(l domain.ValuationLine) bool { return l.SubCategory == sub.Name })
+	s.Require().NotEqual(-1, i)
+	s.Assert().Equal(domain.ValuationLine{
+		MainCategory:     s.products[0].SubCategory.MainCategory.Name,
+		SubCategory:      sub.Name,
+		CurrencyCode:     currency,
+		Quantity:         15,
+		UncostedQuantity: 91,
+		Value:            97,
+	}, valuation[i])
+
+	err = db.Delete(&PurchaseOrder{}, orderIDs).Error
+	s.Require().NoError(err)
+	err = db.Delete(&Supplier{}, supplierID).Error
+	s.Require().NoError(err)
+	productIDs := []int64{fifo.ID, average.ID, opened}
+	err = db.Where("product_id IN ?", productIDs).Delete(&ProductHistory{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id IN ?", productIDs).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, productIDs).Error
+	s.Require().NoError(err)
+	err = db.Delete(&sub).Error
+	s.Require().NoError(err)
+}
+
+func (s *DatabaseTestSuite) TestCostLayersOnHoldsAndAdjustments() {
+	ctx := context.Background()
 	db := s.getGormDB()
 
+	p := Product{Name: "Batgirl", SubCategory: s.products[0].SubCategory, Currency: s.products[0].Currency, StockNumber: 6, ActualPrice: 10, Version: 1}
 	err := db.Save(&p).Error
 	s.Require().NoError(err)
-	s.Assert().NotEmpty(p.ID)
-
-	req := &domain.DeleteProductRequest{
-		ID:      p.ID,
-		Version: p.Version,
+	usdCost, vndCost := 3.0, 5000.0
+	layers := []*CostLayer{
+		{ProductID: p.ID, CurrencyID: s.products[1].CurrencyID, Quantity: 2, RemainingQuantity: 2, UnitCost: &usdCost},
+		{ProductID: p.ID, CurrencyID: p.CurrencyID, Quantity: 4, RemainingQuantity: 4, UnitCost: &vndCost},
 	}
+	err = db.Omit(clause.Associations).Create(&layers).Error
+	s.Require().NoError(err)
+
+	_, err = s.db.AdjustStock(ctx, &domain.AdjustStockRequest{ProductID: p.ID, Delta: 2, Reason: domain.MovementAdjustment})
+	s.Require().NoError(err)
+
+	want, err := s.db.GetCostLayers(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Require().Len(want, 3)
+	s.Assert().Equal(2, want[2].RemainingQuantity)
+	s.Assert().Nil(want[2].UnitCost)
+
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{ProductID: p.ID, OrderID: "order-batgirl-1", Quantity: 3, TTL: -time.Minute})
+	s.Require().NoError(err)
+	_, err = s.db.ReleaseExpiredReservations(ctx, time.Now(), 10)
+	s.Require().NoError(err)
+
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{ProductID: p.ID, OrderID: "order-batgirl-2", Quantity: 2, TTL: time.Hour})
+	s.Require().NoError(err)
+	err = s.db.ReleaseReservation(ctx, "order-batgirl-2")
+	s.Require().NoError(err)
+
+	_, err = s.db.ReserveStock(ctx, &domain.ReserveStockRequest{ProductID: p.ID, OrderID: "order-batgirl-3", Quantity: 5, TTL: time.Hour})
+	s.Require().NoError(err)
 
-	err = s.db.DeleteProduct(context.Background(), req)
+	got, err := s.db.GetCostLayers(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(want, got)
+
+	// The oldest layer goes first, whatever its currency.
+	err = s.db.ConfirmReservation(ctx, "order-batgirl-3")
+	s.Require().NoError(err)
+
+	got, err = s.db.GetCostLayers(ctx, p.ID)
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Assert().Equal(want[1].ID, got[0].ID)
+	s.Assert().Equal(1, got[0].RemainingQuantity)
+	s.Assert().Equal(want[2], got[1])
+
+	err = db.Where("product_id = ?", p.ID).Delete(&Reservation{}).Error
+	s.Require().NoError(err)
+	err = db.Where("product_id = ?", p.ID).Delete(&StockMovement{}).Error
+	s.Require().NoError(err)
+	err = db.Unscoped().Delete(&Product{}, p.ID).Error
 	s.Require().NoError(err)
 }
 
@@ -306,6 +2088,12 @@ func (s *DatabaseTestSuite) setupProducts() {
 		s.T().Fatalf("create products: %s", err)
 	}
 
+	// Reload the products so that timestamps carry the database precision.
+	err = db.Joins("SubCategory.MainCategory").Joins("Currency").Order("id").Find(&products).Error
+	if err != nil {
+		s.T().Fatalf("reload products: %s", err)
+	}
+
 	s.domainProducts = domainProducts(products)
 	s.products = products
 }
@@ -318,9 +2106,9 @@ func (s *DatabaseTestSuite) setupAdapter() {
 		s.T().Fatalf("create new postgres adapter: %s", err)
 	}
 
-	err = postgresDB.AutoMigration(ctx)
+	err = postgresDB.MigrateUp(ctx)
 	if err != nil {
-		s.T().Fatalf("auto migration: %s", err)
+		s.T().Fatalf("migrate up: %s", err)
 	}
 	s.db = postgresDB
 }
@@ -362,6 +2150,63 @@ func (s *DatabaseTestSuite) setupContainer() {
 	s.dsn = url(ctnHost)
 }
 
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		t.Fatalf("load embedded migrations: %s", err)
+	}
+	if len(migrations) == 0 || migrations[0].version != 1 {
+		t.Fatalf("embedded migrations do not start at version 1: %v", migrations)
+	}
+
+	migrations, err = loadMigrations(fstest.MapFS{
+		"migrations/0002_b.up.sql":   {Data: []byte("b up")},
+		"migrations/0002_b.down.sql": {Data: []byte("b down")},
+		"migrations/0001_a.up.sql":   {Data: []byte("a up")},
+		"migrations/0001_a.down.sql": {Data: []byte("a down")},
+	})
+	if err != nil {
+		t.Fatalf("load migrations: %s", err)
+	}
+	want := []migration{
+		{version: 1, name: "a", up: "a up", down: "a down"},
+		{version: 2, name: "b", up: "b up", down: "b down"},
+	}
+	if !reflect.DeepEqual(migrations, want) {
+		t.Errorf("got migrations %v, want %v", migrations, want)
+	}
+
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing down": {"migrations/0001_a.up.sql": {Data: []byte("a up")}},
+		"bad name":     {"migrations/a.up.sql": {Data: []byte("a up")}},
+		"two names": {
+			"migrations/0001_a.up.sql":   {Data: []byte("a up")},
+			"migrations/0001_b.down.sql": {Data: []byte("b down")},
+		},
+	} {
+		_, err := loadMigrations(fsys)
+		if err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
+
+func TestPrefixTSQuery(t *testing.T) {
+	tests := map[string]string{
+		"super man":    "super:* & man:*",
+		"G-Shock":      "g:* & shock:*",
+		"  ":           "",
+		"a & !b | c:*": "a:* & b:* & c:*",
+		"Đồng hồ 2024": "đồng:* & hồ:* & 2024:*",
+	}
+
+	for query, want := range tests {
+		if got := prefixTSQuery(query); got != want {
+			t.Errorf("prefixTSQuery(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
 func (s *DatabaseTestSuite) TeardownSuite() {
 	err := s.container.Terminate(context.Background())
 	if err != nil {
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// GetDeletedProducts returns a page of the soft deleted products.
+func (a *Adapter) GetDeletedProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
+	db := a.db.WithContext(ctx).Unscoped()
+
+	var products []*Product
+	query := filterProducts(db.Model(&products), filter).Where("products.deleted_at IS NOT NULL")
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("count deleted products: %w", err)
+	}
+	if total == 0 {
+		return 0, domainProducts(products), nil
+	}
+
+	err = query.Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Order(productOrder(filter)).
+		Offset(int(filter.Offset())).
+		Limit(filter.Limit()).
+		Find(&products).
+		Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("select deleted products: %w", err)
+	}
+
+	return total, domainProducts(products), nil
+}
+
+// RestoreProduct undoes the soft delete of a product and bumps its version.
+// It fails with domain.ErrNotFound when no deleted product has the id.
+func (a *Adapter) RestoreProduct(ctx context.Context, id int64) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	res := tx.Unscoped().Model(&Product{}).
+		Where("id = ?", id).
+		Where("deleted_at IS NOT NULL").
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if err := res.Error; err != nil {
+		return fmt.Errorf("restore product id=%d: %w", id, err)
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return recordProductChanges(tx, []int64{id}, domain.ProductRestored)
+}
+
+// PurgeDeletedProducts permanently removes up to limit products soft deleted
+// before the given time, together with their warehouse stock rows. Their
+// stock ledger and history are kept. Products still referenced by
+// reservations, stock transfers or purchase orders are kept.
+func (a *Adapter) PurgeDeletedProducts(ctx context.Context, before time.Time, limit int) (n int64, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	// Rows locked by a concurrent purge are skipped rather than waited on.
+	var ids []int64
+	err = tx.Unscoped().Model(&Product{}).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("deleted_at <= ?", before).
+		Where("NOT EXISTS (SELECT 1 FROM reservations WHERE reservations.product_id = products.id)").
+		Where("NOT EXISTS (SELECT 1 FROM stock_transfers WHERE stock_transfers.product_id = products.id)").
+		Where("NOT EXISTS (SELECT 1 FROM purchase_order_lines WHERE purchase_order_lines.product_id = products.id)").
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		return 0, fmt.Errorf("select purgeable products: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	err = tx.Where("product_id IN ?", ids).Delete(&WarehouseStock{}).Error
+	if err != nil {
+		return 0, fmt.Errorf("delete warehouse stocks of purged products: %w", err)
+	}
+
+	err = tx.Unscoped().Where("id IN ?", ids).Delete(&Product{}).Error
+	if err != nil {
+		return 0, fmt.Errorf("delete purged products: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
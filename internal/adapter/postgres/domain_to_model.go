@@ -4,6 +4,8 @@ import "github.com/ebisaan/inventory/internal/application/core/domain"
 
 func insertedProduct(dm *domain.CreateProductRequest) *Product {
 	return &Product{
+		SKU:           nullableString(dm.SKU),
+		Barcode:       nullableString(dm.Barcode),
 		Name:          dm.Name,
 		StockNumber:   dm.StockNumber,
 		Image:         dm.Image,
@@ -15,6 +17,8 @@ func insertedProduct(dm *domain.CreateProductRequest) *Product {
 		Currency: Currency{
 			Code: dm.CurrencyCode,
 		},
+		ReorderPoint:    dm.ReorderPoint,
+		ReorderQuantity: dm.ReorderQuantity,
 	}
 }
 
@@ -23,6 +27,8 @@ func updatedProduct(dm *domain.UpdateProductRequest) *Product {
 		BaseModel: BaseModel{
 			ID: dm.ID,
 		},
+		SKU:           nullableString(dm.SKU),
+		Barcode:       nullableString(dm.Barcode),
 		Name:          dm.Name,
 		StockNumber:   dm.StockNumber,
 		Image:         dm.Image,
@@ -34,6 +40,18 @@ func updatedProduct(dm *domain.UpdateProductRequest) *Product {
 		Currency: Currency{
 			Code: dm.CurrencyCode,
 		},
-		Version: dm.Version,
+		ReorderPoint:    dm.ReorderPoint,
+		ReorderQuantity: dm.ReorderQuantity,
+		Version:         dm.Version,
 	}
 }
+
+// nullableString maps an empty string to NULL, which unique indexes allow
+// more than once.
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
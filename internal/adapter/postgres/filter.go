@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// productSortColumns maps the sort fields of domain.Filter to columns.
+var productSortColumns = map[string]string{
+	domain.SortByName:      "products.name",
+	domain.SortByPrice:     "products.actual_price",
+	domain.SortByStock:     "products.stock_number",
+	domain.SortByCreatedAt: "products.created_at",
+}
+
+// filterProducts adds the conditions of filter to a query on products.
+// Associations are matched through subqueries so that the query can be
+// counted with or without joins.
+func filterProducts(db *gorm.DB, filter domain.Filter) *gorm.DB {
+	if filter.MainCategory != "" {
+		db = db.Where(`products.sub_category_id IN (
+			SELECT sc.id FROM sub_categories sc
+			JOIN main_categories mc ON mc.id = sc.main_category_id
+			WHERE mc.name = ?)`, filter.MainCategory)
+	}
+
+	if filter.SubCategory != "" {
+		db = db.Where("products.sub_category_id IN (SELECT id FROM sub_categories WHERE name = ?)", filter.SubCategory)
+	}
+
+	if filter.CurrencyCode != "" {
+		db = db.Where("products.currency_id IN (SELECT id FROM currencies WHERE code = ?)", filter.CurrencyCode)
+	}
+
+	if filter.MinPrice > 0 {
+		db = db.Where("products.actual_price >= ?", filter.MinPrice)
+	}
+
+	if filter.MaxPrice > 0 {
+		db = db.Where("products.actual_price <= ?", filter.MaxPrice)
+	}
+
+	if filter.InStockOnly {
+		db = db.Where("products.stock_number > 0")
+	}
+
+	if filter.Name != "" {
+		db = db.Where("products.name ILIKE ?", "%"+escapeLike(filter.Name)+"%")
+	}
+
+	return db
+}
+
+// productOrder returns the ORDER BY clause of filter. The product ID breaks
+// ties, so that pages are stable. The order is reversed when paging
+// backward from a cursor.
+func productOrder(filter domain.Filter) string {
+	direction := "ASC"
+	if descending(filter) {
+		direction = "DESC"
+	}
+
+	column, ok := productSortColumns[filter.SortBy]
+	if !ok {
+		return "products.id " + direction
+	}
+
+	return fmt.Sprintf("%s %s, products.id %s", column, direction, direction)
+}
+
+// keysetCondition selects the products after filter.Position in the order
+// of productOrder.
+func keysetCondition(filter domain.Filter) (clause.Expr, error) {
+	op := ">"
+	if descending(filter) {
+		op = "<"
+	}
+
+	c := filter.Position
+	column, ok := productSortColumns[filter.SortBy]
+	if !ok {
+		return clause.Expr{SQL: "products.id " + op + " ?", Vars: []any{c.ID}}, nil
+	}
+
+	value, err := parseSortValue(filter.SortBy, c.Value)
+	if err != nil {
+		return clause.Expr{}, fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+	}
+
+	return clause.Expr{
+		SQL:  fmt.Sprintf("(%s, products.id) %s (?, ?)", column, op),
+		Vars: []any{value, c.ID},
+	}, nil
+}
+
+func descending(filter domain.Filter) bool {
+	desc := filter.SortDirection == domain.SortDesc
+	if filter.Position != nil && filter.Position.Backward {
+		return !desc
+	}
+
+	return desc
+}
+
+// parseSortValue converts the sort key stored in a cursor back to the type
+// of its column.
+func parseSortValue(sortBy, value string) (any, error) {
+	switch sortBy {
+	case domain.SortByPrice:
+		return strconv.ParseFloat(value, 64)
+	case domain.SortByStock:
+		return strconv.Atoi(value)
+	case domain.SortByCreatedAt:
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return value, nil
+	}
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func escapeLike(s string) string {
+	return likeReplacer.Replace(s)
+}
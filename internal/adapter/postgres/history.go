@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// recordProductChanges stores a snapshot of each of the products as they are
+// in the transaction, attributed to the actor of its context, and adds it to
+// the outbox as the payload of the matching product event. Soft deleted
+// products are snapshotted too.
+func recordProductChanges(tx *gorm.DB, ids []int64, change domain.ProductChange) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var products []*Product
+	err := tx.Unscoped().Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Where("products.id IN ?", ids).
+		Order("products.id").
+		Find(&products).
+		Error
+	if err != nil {
+		return fmt.Errorf("select changed products: %w", err)
+	}
+
+	actor := domain.ActorFromContext(tx.Statement.Context)
+	now := time.Now()
+	history := make([]*ProductHistory, len(products))
+	events := make([]*OutboxEvent, len(products))
+	for i, p := range products {
+		snapshot, err := json.Marshal(domainProduct(p))
+		if err != nil {
+			return fmt.Errorf("encode snapshot of product id=%d: %w", p.ID, err)
+		}
+
+		history[i] = &ProductHistory{
+			ProductID: p.ID,
+			Version:   p.Version,
+			Change:    string(change),
+			Actor:     actor,
+			ChangedAt: now,
+			Snapshot:  string(snapshot),
+		}
+		events[i] = &OutboxEvent{
+			Type:      string(domain.ProductEventType(change)),
+			ProductID: p.ID,
+			Payload:   string(snapshot),
+		}
+	}
+
+	err = tx.Create(&history).Error
+	if err != nil {
+		return fmt.Errorf("insert product history: %w", err)
+	}
+
+	return insertOutboxEvents(tx, events)
+}
+
+// GetProductHistory returns the snapshots of the product, oldest first. The
+// history outlives the product, purged or not.
+func (a *Adapter) GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error) {
+	db := a.db.WithContext(ctx)
+
+	var history []*ProductHistory
+	err := db.Where("product_id = ?", productID).Order("changed_at").Order("id").Find(&history).Error
+	if err != nil {
+		return nil, fmt.Errorf("select history of product id=%d: %w", productID, err)
+	}
+
+	snapshots := make([]*domain.ProductSnapshot, len(history))
+	for i, h := range history {
+		snapshots[i], err = domainProductSnapshot(h)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetProductSnapshot returns the latest snapshot of the product taken at or
+// before the given time, or domain.ErrNotFound. Stock changes take no
+// snapshot, so the stock number is the one the stock ledger had then.
+func (a *Adapter) GetProductSnapshot(ctx context.Context, productID int64, at time.Time) (*domain.ProductSnapshot, error) {
+	db := a.db.WithContext(ctx)
+
+	h := &ProductHistory{}
+	err := db.Where("product_id = ?", productID).
+		Where("changed_at <= ?", at).
+		Order("changed_at DESC").
+		Order("id DESC").
+		First(h).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select snapshot of product id=%d at %s: %w", productID, at, err)
+		}
+	}
+
+	snapshot, err := domainProductSnapshot(h)
+	if err != nil {
+		return nil, err
+	}
+
+	var movements []StockMovement
+	err = db.Where("product_id = ? AND variant_id IS NULL", productID).
+		Where("created_at <= ?", at).
+		Order("id DESC").
+		Limit(1).
+		Find(&movements).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select stock of product id=%d at %s: %w", productID, at, err)
+	}
+	if len(movements) > 0 {
+		snapshot.Product.StockNumber = movements[0].StockAfter
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// CreateProducts inserts the products in a single transaction and returns
+// their ids in order. Subcategories and currencies are resolved with one
+// query each, and a missing one fails the whole batch with
+// domain.ErrAssociationNotFound.
+func (a *Adapter) CreateProducts(ctx context.Context, reqs []*domain.CreateProductRequest) (ids []int64, err error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	names := make([]string, len(reqs))
+	codes := make([]string, len(reqs))
+	for i, req := range reqs {
+		names[i] = req.SubCategory
+		codes[i] = req.CurrencyCode
+	}
+
+	scIDs, err := idsByColumn(tx.Model(&SubCategory{}), "name", names)
+	if err != nil {
+		return nil, fmt.Errorf("select subcategory ids: %w", err)
+	}
+	crcIDs, err := idsByColumn(tx.Model(&Currency{}), "code", codes)
+	if err != nil {
+		return nil, fmt.Errorf("select currency ids: %w", err)
+	}
+
+	products := make([]*Product, len(reqs))
+	for i, req := range reqs {
+		p := insertedProduct(req)
+
+		var ok bool
+		p.SubCategoryID, ok = scIDs[req.SubCategory]
+		if !ok {
+			return nil, domain.ErrAssociationNotFound
+		}
+		p.CurrencyID, ok = crcIDs[req.CurrencyCode]
+		if !ok {
+			return nil, domain.ErrAssociationNotFound
+		}
+
+		products[i] = p
+	}
+
+	err = tx.Omit(clause.Associations).Create(&products).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return nil, domain.ErrAlreadyExists
+		default:
+			return nil, fmt.Errorf("insert products: %w", err)
+		}
+	}
+
+	ids = make([]int64, len(products))
+	for i, p := range products {
+		ids[i] = p.ID
+	}
+
+	err = recordProductChanges(tx, ids, domain.ProductCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	err = recordInitialStock(tx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (a *Adapter) FindMissingSubCategories(ctx context.Context, names []string) ([]string, error) {
+	db := a.db.WithContext(ctx)
+
+	found, err := idsByColumn(db.Model(&SubCategory{}), "name", names)
+	if err != nil {
+		return nil, fmt.Errorf("select subcategories: %w", err)
+	}
+
+	return missing(names, found), nil
+}
+
+// FindMissingCurrencyCodes returns the codes without an enabled currency.
+func (a *Adapter) FindMissingCurrencyCodes(ctx context.Context, codes []string) ([]string, error) {
+	db := a.db.WithContext(ctx)
+
+	found, err := idsByColumn(db.Model(&Currency{}).Where("disabled = ?", false), "code", codes)
+	if err != nil {
+		return nil, fmt.Errorf("select currencies: %w", err)
+	}
+
+	return missing(codes, found), nil
+}
+
+// FindTakenIdentifiers returns the skus and barcodes already used by a
+// product. Soft deleted products keep theirs, so that they can be restored.
+func (a *Adapter) FindTakenIdentifiers(ctx context.Context, skus, barcodes []string) ([]string, []string, error) {
+	db := a.db.WithContext(ctx)
+
+	var takenSKUs, takenBarcodes []string
+	if len(skus) > 0 {
+		found, err := idsByColumn(db.Unscoped().Model(&Product{}), "sku", skus)
+		if err != nil {
+			return nil, nil, fmt.Errorf("select products by sku: %w", err)
+		}
+		takenSKUs = present(skus, found)
+	}
+	if len(barcodes) > 0 {
+		found, err := idsByColumn(db.Unscoped().Model(&Product{}), "barcode", barcodes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("select products by barcode: %w", err)
+		}
+		takenBarcodes = present(barcodes, found)
+	}
+
+	return takenSKUs, takenBarcodes, nil
+}
+
+// idsByColumn maps the values of a unique column to the ids of the rows
+// holding them.
+func idsByColumn(query *gorm.DB, column string, values []string) (map[string]int64, error) {
+	var rows []struct {
+		ID    int64
+		Value string
+	}
+	err := query.Select("id", column+" AS value").Where(column+" IN ?", values).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		ids[r.Value] = r.ID
+	}
+
+	return ids, nil
+}
+
+func missing(values []string, found map[string]int64) []string {
+	var m []string
+	for _, v := range values {
+		if _, ok := found[v]; !ok {
+			m = append(m, v)
+		}
+	}
+
+	return m
+}
+
+func present(values []string, found map[string]int64) []string {
+	var p []string
+	for _, v := range values {
+		if _, ok := found[v]; ok {
+			p = append(p, v)
+		}
+	}
+
+	return p
+}
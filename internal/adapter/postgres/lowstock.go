@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// GetLowStockProducts returns a page of the products whose stock is at or
+// below their reorder point.
+func (a *Adapter) GetLowStockProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
+	db := a.db.WithContext(ctx)
+
+	var products []*Product
+	query := filterProducts(db.Model(&products), filter).
+		Where("products.stock_number <= products.reorder_point")
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("count low stock products: %w", err)
+	}
+	if total == 0 {
+		return 0, domainProducts(products), nil
+	}
+
+	err = query.Joins("SubCategory.MainCategory").Joins("Currency").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Preload("Variants", orderByID).
+		Order(productOrder(filter)).
+		Offset(int(filter.Offset())).
+		Limit(filter.Limit()).
+		Find(&products).
+		Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("select low stock products: %w", err)
+	}
+
+	return total, domainProducts(products), nil
+}
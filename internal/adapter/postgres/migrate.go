@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID keys the advisory lock held while migrations run, so that
+// concurrent runs against the same database wait for each other.
+const migrationLockID = 7_205_461_389
+
+var ErrUnknownMigration = errors.New("unknown migration version")
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// MigrationStatus tells whether a migration has been applied to the
+// database and when.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type schemaMigration struct {
+	Version   int64  `gorm:"primarykey;autoIncrement:false"`
+	Name      string `gorm:"not null"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// loadMigrations reads the migrations under migrations/, ordered by version.
+// Every version needs both an up and a down file.
+func loadMigrations(fsys fs.FS) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, e := range entries {
+		match := migrationFileName.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q: name is not <version>_<name>.<up|down>.sql", e.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration file %q: %w", e.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: match[2]}
+			byVersion[version] = m
+		}
+		if m.name != match[2] {
+			return nil, fmt.Errorf("migration version %d has two names %q and %q", version, m.name, match[2])
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join("migrations", e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read migration file %q: %w", e.Name(), err)
+		}
+		if match[3] == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %d_%s lacks an up or a down file", m.version, m.name)
+		}
+		migrations = append(migrations, *m)
+	}
+	slices.SortFunc(migrations, func(a, b migration) int {
+		return int(a.version - b.version)
+	})
+
+	return migrations, nil
+}
+
+// MigrateUp applies every pending migration.
+func (a *Adapter) MigrateUp(ctx context.Context) error {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	return a.migrateTo(ctx, migrations, migrations[len(migrations)-1].version)
+}
+
+// MigrateDown reverts the given number of the most recently applied
+// migrations.
+func (a *Adapter) MigrateDown(ctx context.Context, steps int) error {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return err
+	}
+
+	return a.withMigrationLock(ctx, func(conn *gorm.DB) error {
+		applied, err := appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int64, 0, len(applied))
+		for v := range applied {
+			versions = append(versions, v)
+		}
+		slices.Sort(versions)
+
+		var target int64
+		if steps < len(versions) {
+			target = versions[len(versions)-steps-1]
+		}
+
+		return migrate(conn, migrations, applied, target)
+	})
+}
+
+// MigrateTo applies or reverts migrations until the given version is the
+// latest applied one. Version 0 reverts every migration.
+func (a *Adapter) MigrateTo(ctx context.Context, version int64) error {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return err
+	}
+
+	if version != 0 && !slices.ContainsFunc(migrations, func(m migration) bool { return m.version == version }) {
+		return fmt.Errorf("%w: %d", ErrUnknownMigration, version)
+	}
+
+	return a.migrateTo(ctx, migrations, version)
+}
+
+// MigrationStatus lists the known migrations and whether they are applied.
+func (a *Adapter) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	err = a.withMigrationLock(ctx, func(conn *gorm.DB) error {
+		applied, err := appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			s := MigrationStatus{Version: m.version, Name: m.name}
+			if sm, ok := applied[m.version]; ok {
+				s.Applied = true
+				s.AppliedAt = sm.AppliedAt
+			}
+			statuses = append(statuses, s)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+func (a *Adapter) migrateTo(ctx context.Context, migrations []migration, version int64) error {
+	return a.withMigrationLock(ctx, func(conn *gorm.DB) error {
+		applied, err := appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+
+		return migrate(conn, migrations, applied, version)
+	})
+}
+
+// withMigrationLock runs fn on a single connection holding the migration
+// advisory lock, after making sure the schema_migrations table exists.
+func (a *Adapter) withMigrationLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return a.db.WithContext(ctx).Connection(func(conn *gorm.DB) (err error) {
+		err = conn.Exec("SELECT pg_advisory_lock(?)", migrationLockID).Error
+		if err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+		defer func() {
+			unlockErr := conn.WithContext(context.Background()).
+				Exec("SELECT pg_advisory_unlock(?)", migrationLockID).
+				Error
+			if unlockErr != nil {
+				err = errors.Join(err, fmt.Errorf("release migration lock: %w", unlockErr))
+			}
+		}()
+
+		err = conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)`).Error
+		if err != nil {
+			return fmt.Errorf("create schema_migrations: %w", err)
+		}
+
+		return fn(conn)
+	})
+}
+
+func appliedMigrations(conn *gorm.DB) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	err := conn.Order("version").Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("select schema migrations: %w", err)
+	}
+
+	applied := make(map[int64]schemaMigration, len(rows))
+	for _, r := range rows {
+		applied[r.Version] = r
+	}
+
+	return applied, nil
+}
+
+// migrate reverts the applied migrations above version, newest first, then
+// applies the pending ones up to version, oldest first. Each migration runs
+// in its own transaction together with its schema_migrations bookkeeping.
+func migrate(conn *gorm.DB, migrations []migration, applied map[int64]schemaMigration, version int64) error {
+	for v := range applied {
+		if !slices.ContainsFunc(migrations, func(m migration) bool { return m.version == v }) {
+			return fmt.Errorf("%w: %d is applied but has no migration files", ErrUnknownMigration, v)
+		}
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.version]; !ok || m.version <= version {
+			continue
+		}
+
+		err := conn.Transaction(func(tx *gorm.DB) error {
+			err := tx.Exec(m.down).Error
+			if err != nil {
+				return err
+			}
+
+			return tx.Delete(&schemaMigration{}, m.version).Error
+		})
+		if err != nil {
+			return fmt.Errorf("revert migration %d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	for _, m := range migrations {
+		if _, ok := applied[m.version]; ok || m.version > version {
+			continue
+		}
+
+		err := conn.Transaction(func(tx *gorm.DB) error {
+			err := tx.Exec(m.up).Error
+			if err != nil {
+				return err
+			}
+
+			return tx.Create(&schemaMigration{
+				Version:   m.version,
+				Name:      m.name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("apply migration %d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	return nil
+}
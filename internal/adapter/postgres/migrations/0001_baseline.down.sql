@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS stock_transfers;
+DROP TABLE IF EXISTS warehouse_stocks;
+DROP TABLE IF EXISTS warehouses;
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS reservations;
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS products;
+DROP TABLE IF EXISTS sub_categories;
+DROP TABLE IF EXISTS main_categories;
+DROP TABLE IF EXISTS currencies;
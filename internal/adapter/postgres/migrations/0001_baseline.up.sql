@@ -0,0 +1,147 @@
+-- The baseline matches the schema created by GORM AutoMigrate, so that
+-- databases set up before versioned migrations are adopted. AutoMigrate
+-- never changed existing constraints, and tables created by an earlier
+-- release may lack later columns, so those are brought up to date here.
+
+CREATE TABLE IF NOT EXISTS currencies (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    code text NOT NULL,
+    symbol text NOT NULL,
+    disabled boolean NOT NULL DEFAULT false
+);
+ALTER TABLE currencies ADD COLUMN IF NOT EXISTS disabled boolean NOT NULL DEFAULT false;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_currencies_code ON currencies (code);
+DROP INDEX IF EXISTS idx_currencies_symbol;
+
+CREATE TABLE IF NOT EXISTS main_categories (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    name text NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_main_categories_name ON main_categories (name);
+
+CREATE TABLE IF NOT EXISTS sub_categories (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    name text NOT NULL,
+    main_category_id bigint NOT NULL,
+    CONSTRAINT fk_sub_categories_main_category FOREIGN KEY (main_category_id) REFERENCES main_categories (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sub_categories_name ON sub_categories (name);
+
+CREATE TABLE IF NOT EXISTS products (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    name text NOT NULL,
+    stock_number bigint NOT NULL,
+    image text,
+    discount_price decimal,
+    actual_price decimal NOT NULL,
+    sub_category_id bigint NOT NULL,
+    currency_id bigint NOT NULL,
+    version bigint NOT NULL DEFAULT 1,
+    CONSTRAINT chk_products_stock_number CHECK (stock_number >= 0),
+    CONSTRAINT chk_products_discount_price CHECK (discount_price >= 0),
+    CONSTRAINT chk_products_actual_price CHECK (actual_price >= 0),
+    CONSTRAINT fk_products_sub_category FOREIGN KEY (sub_category_id) REFERENCES sub_categories (id),
+    CONSTRAINT fk_products_currency FOREIGN KEY (currency_id) REFERENCES currencies (id)
+);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS chk_products_stock_number,
+    ADD CONSTRAINT chk_products_stock_number CHECK (stock_number >= 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', coalesce(name, ''))) STORED;
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    base_currency_id bigint NOT NULL,
+    quote_currency_id bigint NOT NULL,
+    rate numeric(24, 12) NOT NULL,
+    effective_date date NOT NULL,
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT fk_exchange_rates_base_currency FOREIGN KEY (base_currency_id) REFERENCES currencies (id),
+    CONSTRAINT fk_exchange_rates_quote_currency FOREIGN KEY (quote_currency_id) REFERENCES currencies (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exchange_rates_pair_date
+    ON exchange_rates (base_currency_id, quote_currency_id, effective_date);
+
+CREATE TABLE IF NOT EXISTS reservations (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    product_id bigint NOT NULL,
+    order_id text NOT NULL,
+    quantity bigint NOT NULL,
+    status text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    CONSTRAINT chk_reservations_quantity CHECK (quantity > 0),
+    CONSTRAINT fk_reservations_product FOREIGN KEY (product_id) REFERENCES products (id)
+);
+CREATE INDEX IF NOT EXISTS idx_reservations_product_id ON reservations (product_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_order_id ON reservations (order_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_status ON reservations (status);
+CREATE INDEX IF NOT EXISTS idx_reservations_expires_at ON reservations (expires_at);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id bigserial PRIMARY KEY,
+    product_id bigint NOT NULL,
+    warehouse_id bigint,
+    reason text NOT NULL,
+    quantity bigint NOT NULL,
+    stock_after bigint NOT NULL,
+    reference text NOT NULL DEFAULT '',
+    created_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_product_id ON stock_movements (product_id);
+ALTER TABLE stock_movements ADD COLUMN IF NOT EXISTS warehouse_id bigint;
+CREATE INDEX IF NOT EXISTS idx_stock_movements_warehouse_id ON stock_movements (warehouse_id);
+
+CREATE TABLE IF NOT EXISTS warehouses (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    code text NOT NULL,
+    name text NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_warehouses_code ON warehouses (code);
+
+CREATE TABLE IF NOT EXISTS warehouse_stocks (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    product_id bigint NOT NULL,
+    warehouse_id bigint NOT NULL,
+    stock_number bigint NOT NULL,
+    CONSTRAINT chk_warehouse_stocks_stock_number CHECK (stock_number >= 0),
+    CONSTRAINT fk_products_warehouse_stocks FOREIGN KEY (product_id) REFERENCES products (id),
+    CONSTRAINT fk_warehouse_stocks_warehouse FOREIGN KEY (warehouse_id) REFERENCES warehouses (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_warehouse_stocks_product_warehouse
+    ON warehouse_stocks (product_id, warehouse_id);
+CREATE INDEX IF NOT EXISTS idx_warehouse_stocks_warehouse_id ON warehouse_stocks (warehouse_id);
+
+CREATE TABLE IF NOT EXISTS stock_transfers (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    product_id bigint NOT NULL,
+    from_warehouse_id bigint NOT NULL,
+    to_warehouse_id bigint NOT NULL,
+    quantity bigint NOT NULL,
+    status text NOT NULL,
+    CONSTRAINT chk_stock_transfers_quantity CHECK (quantity > 0),
+    CONSTRAINT fk_stock_transfers_product FOREIGN KEY (product_id) REFERENCES products (id),
+    CONSTRAINT fk_stock_transfers_from_warehouse FOREIGN KEY (from_warehouse_id) REFERENCES warehouses (id),
+    CONSTRAINT fk_stock_transfers_to_warehouse FOREIGN KEY (to_warehouse_id) REFERENCES warehouses (id)
+);
+CREATE INDEX IF NOT EXISTS idx_stock_transfers_product_id ON stock_transfers (product_id);
+CREATE INDEX IF NOT EXISTS idx_stock_transfers_status ON stock_transfers (status);
@@ -0,0 +1,3 @@
+ALTER TABLE products ADD COLUMN sku text, ADD COLUMN barcode text;
+CREATE UNIQUE INDEX idx_products_sku ON products (sku);
+CREATE UNIQUE INDEX idx_products_barcode ON products (barcode);
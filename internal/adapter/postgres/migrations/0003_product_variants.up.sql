@@ -0,0 +1,18 @@
+CREATE TABLE product_variants (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    product_id bigint NOT NULL,
+    sku text,
+    options jsonb NOT NULL,
+    stock_number bigint NOT NULL,
+    discount_price decimal,
+    actual_price decimal,
+    version bigint NOT NULL DEFAULT 1,
+    CONSTRAINT chk_product_variants_stock_number CHECK (stock_number >= 0),
+    CONSTRAINT chk_product_variants_discount_price CHECK (discount_price >= 0),
+    CONSTRAINT chk_product_variants_actual_price CHECK (actual_price > 0),
+    CONSTRAINT fk_products_variants FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX idx_product_variants_product_options ON product_variants (product_id, options);
+CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants (sku);
@@ -0,0 +1,2 @@
+-- Soft deleted products become visible again.
+ALTER TABLE products DROP COLUMN deleted_at;
@@ -0,0 +1,10 @@
+CREATE TABLE product_history (
+    id bigserial PRIMARY KEY,
+    product_id bigint NOT NULL,
+    version bigint NOT NULL,
+    change text NOT NULL,
+    actor text NOT NULL,
+    changed_at timestamptz NOT NULL,
+    snapshot jsonb NOT NULL
+);
+CREATE INDEX idx_product_history_product_changed_at ON product_history (product_id, changed_at);
@@ -0,0 +1,9 @@
+CREATE TABLE outbox_events (
+    id bigserial PRIMARY KEY,
+    type text NOT NULL,
+    product_id bigint NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz,
+    published_at timestamptz
+);
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events (id) WHERE published_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TRIGGER outbox_events_notify ON outbox_events;
+DROP FUNCTION notify_product_change();
@@ -0,0 +1,10 @@
+CREATE FUNCTION notify_product_change() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('product_changes', NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT ON outbox_events
+    FOR EACH ROW EXECUTE FUNCTION notify_product_change();
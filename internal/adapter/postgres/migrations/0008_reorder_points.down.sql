@@ -0,0 +1 @@
+ALTER TABLE products DROP COLUMN reorder_quantity, DROP COLUMN reorder_point;
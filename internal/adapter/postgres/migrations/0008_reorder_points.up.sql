@@ -0,0 +1,6 @@
+ALTER TABLE products
+    ADD COLUMN reorder_point bigint,
+    ADD COLUMN reorder_quantity bigint NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_products_reorder_point CHECK (reorder_point >= 0),
+    ADD CONSTRAINT chk_products_reorder_quantity CHECK (reorder_quantity >= 0);
+CREATE INDEX idx_products_low_stock ON products (id) WHERE stock_number <= reorder_point;
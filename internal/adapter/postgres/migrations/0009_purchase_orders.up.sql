@@ -0,0 +1,39 @@
+CREATE TABLE suppliers (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    name text NOT NULL,
+    email text NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX idx_suppliers_name ON suppliers (name);
+
+CREATE TABLE purchase_orders (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    supplier_id bigint NOT NULL,
+    currency_id bigint NOT NULL,
+    status text NOT NULL,
+    reference text NOT NULL DEFAULT '',
+    expected_at timestamptz,
+    CONSTRAINT fk_purchase_orders_supplier FOREIGN KEY (supplier_id) REFERENCES suppliers (id),
+    CONSTRAINT fk_purchase_orders_currency FOREIGN KEY (currency_id) REFERENCES currencies (id)
+);
+CREATE INDEX idx_purchase_orders_supplier_id ON purchase_orders (supplier_id);
+CREATE INDEX idx_purchase_orders_status ON purchase_orders (status);
+
+CREATE TABLE purchase_order_lines (
+    id bigserial PRIMARY KEY,
+    purchase_order_id bigint NOT NULL,
+    product_id bigint NOT NULL,
+    quantity bigint NOT NULL,
+    received_quantity bigint NOT NULL DEFAULT 0,
+    unit_cost decimal NOT NULL,
+    CONSTRAINT chk_purchase_order_lines_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_purchase_order_lines_received_quantity CHECK (received_quantity BETWEEN 0 AND quantity),
+    CONSTRAINT chk_purchase_order_lines_unit_cost CHECK (unit_cost >= 0),
+    CONSTRAINT fk_purchase_orders_lines FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    CONSTRAINT fk_purchase_order_lines_product FOREIGN KEY (product_id) REFERENCES products (id)
+);
+CREATE UNIQUE INDEX idx_purchase_order_lines_order_product ON purchase_order_lines (purchase_order_id, product_id);
+CREATE INDEX idx_purchase_order_lines_product_id ON purchase_order_lines (product_id);
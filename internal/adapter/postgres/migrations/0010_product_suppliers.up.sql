@@ -0,0 +1,19 @@
+CREATE TABLE product_suppliers (
+    product_id bigint NOT NULL,
+    supplier_id bigint NOT NULL,
+    supplier_sku text NOT NULL DEFAULT '',
+    unit_cost decimal NOT NULL,
+    currency_id bigint NOT NULL,
+    lead_time_days bigint NOT NULL DEFAULT 0,
+    preferred boolean NOT NULL DEFAULT false,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (product_id, supplier_id),
+    CONSTRAINT chk_product_suppliers_unit_cost CHECK (unit_cost >= 0),
+    CONSTRAINT chk_product_suppliers_lead_time_days CHECK (lead_time_days >= 0),
+    CONSTRAINT fk_product_suppliers_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE,
+    CONSTRAINT fk_product_suppliers_supplier FOREIGN KEY (supplier_id) REFERENCES suppliers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_product_suppliers_currency FOREIGN KEY (currency_id) REFERENCES currencies (id)
+);
+CREATE INDEX idx_product_suppliers_supplier_id ON product_suppliers (supplier_id);
+CREATE UNIQUE INDEX idx_product_suppliers_preferred ON product_suppliers (product_id) WHERE preferred;
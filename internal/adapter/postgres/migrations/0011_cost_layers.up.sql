@@ -0,0 +1,18 @@
+CREATE TABLE cost_layers (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    product_id bigint NOT NULL,
+    currency_id bigint NOT NULL,
+    quantity bigint NOT NULL,
+    remaining_quantity bigint NOT NULL,
+    unit_cost decimal NOT NULL,
+    reference text NOT NULL DEFAULT '',
+    CONSTRAINT chk_cost_layers_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_cost_layers_remaining_quantity CHECK (remaining_quantity >= 0),
+    CONSTRAINT chk_cost_layers_unit_cost CHECK (unit_cost >= 0),
+    CONSTRAINT fk_cost_layers_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE,
+    CONSTRAINT fk_cost_layers_currency FOREIGN KEY (currency_id) REFERENCES currencies (id)
+);
+CREATE INDEX idx_cost_layers_product_id ON cost_layers (product_id, id);
+CREATE INDEX idx_cost_layers_open ON cost_layers (product_id, id) WHERE remaining_quantity > 0;
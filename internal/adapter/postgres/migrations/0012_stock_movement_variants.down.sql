@@ -0,0 +1,2 @@
+DROP INDEX idx_stock_movements_variant_id;
+ALTER TABLE stock_movements DROP COLUMN variant_id;
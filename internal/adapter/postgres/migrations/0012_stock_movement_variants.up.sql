@@ -0,0 +1,2 @@
+ALTER TABLE stock_movements ADD COLUMN variant_id bigint;
+CREATE INDEX idx_stock_movements_variant_id ON stock_movements (variant_id);
@@ -0,0 +1 @@
+DROP INDEX idx_outbox_events_created_at;
@@ -0,0 +1,2 @@
+DELETE FROM cost_layers WHERE unit_cost IS NULL;
+ALTER TABLE cost_layers ALTER COLUMN unit_cost SET NOT NULL;
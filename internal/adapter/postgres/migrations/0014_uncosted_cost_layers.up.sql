@@ -0,0 +1,15 @@
+ALTER TABLE cost_layers ALTER COLUMN unit_cost DROP NOT NULL;
+
+-- Stock without a cost layer so far is opened as an uncosted layer dated at
+-- the creation of its product.
+INSERT INTO cost_layers (created_at, updated_at, product_id, currency_id, quantity, remaining_quantity)
+SELECT products.created_at,
+    now(),
+    products.id,
+    products.currency_id,
+    products.stock_number - COALESCE(SUM(cost_layers.remaining_quantity), 0),
+    products.stock_number - COALESCE(SUM(cost_layers.remaining_quantity), 0)
+FROM products
+LEFT JOIN cost_layers ON cost_layers.product_id = products.id
+GROUP BY products.id
+HAVING products.stock_number - COALESCE(SUM(cost_layers.remaining_quantity), 0) > 0;
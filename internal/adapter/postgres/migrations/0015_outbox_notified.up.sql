@@ -0,0 +1,5 @@
+ALTER TABLE outbox_events ADD COLUMN notified_at timestamptz;
+-- Low stock alerts relayed so far went to the notifier along with the
+-- publisher.
+UPDATE outbox_events SET notified_at = published_at WHERE type = 'stock.low';
+CREATE INDEX idx_outbox_events_unnotified ON outbox_events (id) WHERE type = 'stock.low' AND notified_at IS NULL;
@@ -1,7 +1,12 @@
 package postgres
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type BaseModel struct {
@@ -13,8 +18,10 @@ type BaseModel struct {
 type Product struct {
 	BaseModel
 
-	Name        string `gorm:"not null"`
-	StockNumber int    `gorm:"type=integer;not null;check:stock_number > 0"`
+	SKU         *string `gorm:"column:sku;uniqueIndex"`
+	Barcode     *string `gorm:"uniqueIndex"`
+	Name        string  `gorm:"not null"`
+	StockNumber int     `gorm:"type=integer;not null;check:stock_number >= 0"`
 	Image       string
 
 	DiscountPrice float64 `gorm:"check:discount_price >= 0"`
@@ -26,12 +33,33 @@ type Product struct {
 	CurrencyID int64 `gorm:"not null"`
 	Currency   Currency
 
+	WarehouseStocks []WarehouseStock
+	Variants        []ProductVariant
+
+	ReorderPoint    *int `gorm:"check:reorder_point >= 0"`
+	ReorderQuantity int  `gorm:"not null;default:0;check:reorder_quantity >= 0"`
+
+	Version   int64          `gorm:"not null;default:1"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+type ProductVariant struct {
+	BaseModel
+
+	ProductID     int64     `gorm:"not null;uniqueIndex:idx_product_variants_product_options"`
+	SKU           *string   `gorm:"column:sku;uniqueIndex"`
+	Options       stringMap `gorm:"type:jsonb;not null;uniqueIndex:idx_product_variants_product_options"`
+	StockNumber   int       `gorm:"not null;check:stock_number >= 0"`
+	DiscountPrice *float64  `gorm:"check:discount_price >= 0"`
+	ActualPrice   *float64  `gorm:"check:actual_price > 0"`
+
 	Version int64 `gorm:"not null;default:1"`
 }
 
 type MainCategory struct {
 	BaseModel
-	Name string `gorm:"not null;uniqueIndex"`
+	Name          string `gorm:"not null;uniqueIndex"`
+	SubCategories []SubCategory
 }
 
 type SubCategory struct {
@@ -43,6 +71,197 @@ type SubCategory struct {
 
 type Currency struct {
 	BaseModel
-	Code   string `gorm:"not null;uniqueIndex"`
-	Symbol string `gorm:"not null;uniqueIndex"`
+	Code     string `gorm:"not null;uniqueIndex"`
+	Symbol   string `gorm:"not null"`
+	Disabled bool   `gorm:"not null;default:false"`
+}
+
+type ExchangeRate struct {
+	BaseModel
+
+	BaseCurrencyID  int64    `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date,priority:1"`
+	BaseCurrency    Currency `gorm:"foreignKey:BaseCurrencyID"`
+	QuoteCurrencyID int64    `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date,priority:2"`
+	QuoteCurrency   Currency `gorm:"foreignKey:QuoteCurrencyID"`
+
+	Rate          float64   `gorm:"type:numeric(24,12);not null;check:rate > 0"`
+	EffectiveDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_pair_date,priority:3"`
+}
+
+type Reservation struct {
+	BaseModel
+
+	ProductID int64 `gorm:"not null;index"`
+	Product   Product
+
+	OrderID   string    `gorm:"not null;index"`
+	Quantity  int       `gorm:"not null;check:quantity > 0"`
+	Status    string    `gorm:"not null;index"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
+
+// StockMovement rows are never updated nor deleted, and outlive the product
+// they refer to.
+type StockMovement struct {
+	ID          int64     `gorm:"primarykey"`
+	ProductID   int64     `gorm:"not null;index"`
+	WarehouseID *int64    `gorm:"index"`
+	VariantID   *int64    `gorm:"index"`
+	Reason      string    `gorm:"not null"`
+	Quantity    int       `gorm:"not null"`
+	StockAfter  int       `gorm:"not null"`
+	Reference   string    `gorm:"not null;default:''"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+
+	// costed is set on the receipts whose cost layer is added by the caller.
+	costed bool
+}
+
+// CostLayer is a quantity of a product received at the same unit cost. A
+// nil UnitCost marks units that came in without a known cost.
+type CostLayer struct {
+	BaseModel
+
+	ProductID         int64 `gorm:"not null"`
+	CurrencyID        int64 `gorm:"not null"`
+	Currency          Currency
+	Quantity          int      `gorm:"not null;check:quantity > 0"`
+	RemainingQuantity int      `gorm:"not null;check:remaining_quantity >= 0"`
+	UnitCost          *float64 `gorm:"check:unit_cost >= 0"`
+	Reference         string   `gorm:"not null;default:''"`
+}
+
+// ProductHistory holds a snapshot of a product, as a JSON encoded
+// domain.Product, taken right after a change.
+type ProductHistory struct {
+	ID        int64     `gorm:"primarykey"`
+	ProductID int64     `gorm:"not null;index:idx_product_history_product_changed_at,priority:1"`
+	Version   int64     `gorm:"not null"`
+	Change    string    `gorm:"not null"`
+	Actor     string    `gorm:"not null"`
+	ChangedAt time.Time `gorm:"not null;index:idx_product_history_product_changed_at,priority:2"`
+	Snapshot  string    `gorm:"type:jsonb;not null"`
+}
+
+func (ProductHistory) TableName() string {
+	return "product_history"
+}
+
+// OutboxEvent is an event waiting in the outbox until the relay publishes
+// it. Its payload is JSON.
+type OutboxEvent struct {
+	ID          int64     `gorm:"primarykey"`
+	Type        string    `gorm:"not null"`
+	ProductID   int64     `gorm:"not null"`
+	Payload     string    `gorm:"type:jsonb;not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;index"`
+	PublishedAt *time.Time
+	NotifiedAt  *time.Time
+}
+
+type Warehouse struct {
+	BaseModel
+	Code string `gorm:"not null;uniqueIndex"`
+	Name string `gorm:"not null"`
+}
+
+type WarehouseStock struct {
+	BaseModel
+
+	ProductID   int64 `gorm:"not null;uniqueIndex:idx_warehouse_stocks_product_warehouse"`
+	WarehouseID int64 `gorm:"not null;uniqueIndex:idx_warehouse_stocks_product_warehouse;index"`
+	Warehouse   Warehouse
+
+	StockNumber int `gorm:"not null;check:stock_number >= 0"`
+}
+
+type StockTransfer struct {
+	BaseModel
+
+	ProductID int64 `gorm:"not null;index"`
+	Product   Product
+
+	FromWarehouseID int64     `gorm:"not null"`
+	FromWarehouse   Warehouse `gorm:"foreignKey:FromWarehouseID"`
+	ToWarehouseID   int64     `gorm:"not null"`
+	ToWarehouse     Warehouse `gorm:"foreignKey:ToWarehouseID"`
+
+	Quantity int    `gorm:"not null;check:quantity > 0"`
+	Status   string `gorm:"not null;index"`
+}
+
+type Supplier struct {
+	BaseModel
+	Name  string `gorm:"not null;uniqueIndex"`
+	Email string `gorm:"not null;default:''"`
+}
+
+type ProductSupplier struct {
+	ProductID  int64 `gorm:"primaryKey;uniqueIndex:idx_product_suppliers_preferred,where:preferred"`
+	SupplierID int64 `gorm:"primaryKey;index"`
+	Supplier   Supplier
+
+	SupplierSKU  string  `gorm:"not null;default:''"`
+	UnitCost     float64 `gorm:"not null;check:unit_cost >= 0"`
+	CurrencyID   int64   `gorm:"not null"`
+	Currency     Currency
+	LeadTimeDays int  `gorm:"not null;default:0;check:lead_time_days >= 0"`
+	Preferred    bool `gorm:"not null;default:false"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type PurchaseOrder struct {
+	BaseModel
+
+	SupplierID int64 `gorm:"not null;index"`
+	Supplier   Supplier
+
+	CurrencyID int64 `gorm:"not null"`
+	Currency   Currency
+
+	Status     string `gorm:"not null;index"`
+	Reference  string `gorm:"not null;default:''"`
+	ExpectedAt *time.Time
+
+	Lines []PurchaseOrderLine
+}
+
+type PurchaseOrderLine struct {
+	ID               int64   `gorm:"primarykey"`
+	PurchaseOrderID  int64   `gorm:"not null;uniqueIndex:idx_purchase_order_lines_order_product"`
+	ProductID        int64   `gorm:"not null;uniqueIndex:idx_purchase_order_lines_order_product;index"`
+	Quantity         int     `gorm:"not null;check:quantity > 0"`
+	ReceivedQuantity int     `gorm:"not null;default:0;check:received_quantity BETWEEN 0 AND quantity"`
+	UnitCost         float64 `gorm:"not null;check:unit_cost >= 0"`
+}
+
+// stringMap is stored as a jsonb object.
+type stringMap map[string]string
+
+func (m stringMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (m *stringMap) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	case nil:
+		*m = nil
+		return nil
+	default:
+		return errors.New("scan stringMap: unsupported type")
+	}
 }
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/ebisaan/inventory/internal/application/core/domain"
 )
 
@@ -14,8 +17,13 @@ func domainProducts(models []*Product) []*domain.Product {
 }
 
 func domainProduct(model *Product) *domain.Product {
-	return &domain.Product{
+	warehouses := domainWarehouseStocks(model.WarehouseStocks)
+	variants := domainVariants(model.Variants)
+
+	product := &domain.Product{
 		ID:             model.ID,
+		SKU:            stringValue(model.SKU),
+		Barcode:        stringValue(model.Barcode),
 		Name:           model.Name,
 		MainCategory:   model.SubCategory.MainCategory.Name,
 		SubCategory:    model.SubCategory.Name,
@@ -26,5 +34,325 @@ func domainProduct(model *Product) *domain.Product {
 		CurrencyCode:   model.Currency.Code,
 		CurrencySymbol: model.Currency.Symbol,
 		Version:        model.Version,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+
+		ReorderPoint:    model.ReorderPoint,
+		ReorderQuantity: model.ReorderQuantity,
+
+		Warehouses:           warehouses,
+		WarehouseStockNumber: domain.SumWarehouseStocks(warehouses),
+
+		Variants:           variants,
+		VariantStockNumber: domain.SumVariantStocks(variants),
+	}
+	if model.DeletedAt.Valid {
+		product.DeletedAt = &model.DeletedAt.Time
+	}
+
+	return product
+}
+
+// domainVariants returns nil when the product has no variants.
+func domainVariants(models []ProductVariant) []domain.Variant {
+	if len(models) == 0 {
+		return nil
+	}
+
+	variants := make([]domain.Variant, len(models))
+	for i, m := range models {
+		variants[i] = domainVariant(&m)
+	}
+
+	return variants
+}
+
+func domainVariant(model *ProductVariant) domain.Variant {
+	return domain.Variant{
+		ID:            model.ID,
+		ProductID:     model.ProductID,
+		SKU:           stringValue(model.SKU),
+		Options:       model.Options,
+		StockNumber:   model.StockNumber,
+		DiscountPrice: model.DiscountPrice,
+		ActualPrice:   model.ActualPrice,
+		Version:       model.Version,
+	}
+}
+
+func domainReservations(models []*Reservation) []*domain.Reservation {
+	reservations := make([]*domain.Reservation, len(models))
+	for i, m := range models {
+		reservations[i] = domainReservation(m)
+	}
+
+	return reservations
+}
+
+func domainReservation(model *Reservation) *domain.Reservation {
+	return &domain.Reservation{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		OrderID:   model.OrderID,
+		Quantity:  model.Quantity,
+		Status:    domain.ReservationStatus(model.Status),
+		ExpiresAt: model.ExpiresAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+func domainStockLevel(productID int64, level stockLevel) *domain.StockLevel {
+	return &domain.StockLevel{
+		ProductID:   productID,
+		StockNumber: level.StockNumber,
+		Version:     level.Version,
+	}
+}
+
+func domainStockMovements(models []*StockMovement) []*domain.StockMovement {
+	movements := make([]*domain.StockMovement, len(models))
+	for i, m := range models {
+		movements[i] = domainStockMovement(m)
+	}
+
+	return movements
+}
+
+func domainStockMovement(model *StockMovement) *domain.StockMovement {
+	var warehouseID int64
+	if model.WarehouseID != nil {
+		warehouseID = *model.WarehouseID
+	}
+	var variantID int64
+	if model.VariantID != nil {
+		variantID = *model.VariantID
+	}
+
+	return &domain.StockMovement{
+		ID:          model.ID,
+		ProductID:   model.ProductID,
+		WarehouseID: warehouseID,
+		VariantID:   variantID,
+		Reason:      domain.StockMovementReason(model.Reason),
+		Quantity:    model.Quantity,
+		StockAfter:  model.StockAfter,
+		Reference:   model.Reference,
+		CreatedAt:   model.CreatedAt,
+	}
+}
+
+func domainWarehouses(models []*Warehouse) []*domain.Warehouse {
+	warehouses := make([]*domain.Warehouse, len(models))
+	for i, m := range models {
+		warehouses[i] = domainWarehouse(m)
+	}
+
+	return warehouses
+}
+
+func domainWarehouse(model *Warehouse) *domain.Warehouse {
+	return &domain.Warehouse{
+		ID:   model.ID,
+		Code: model.Code,
+		Name: model.Name,
+	}
+}
+
+// domainWarehouseStocks returns nil when the product holds no stock in any
+// warehouse.
+func domainWarehouseStocks(models []WarehouseStock) []domain.WarehouseStock {
+	if len(models) == 0 {
+		return nil
+	}
+
+	stocks := make([]domain.WarehouseStock, len(models))
+	for i, m := range models {
+		stocks[i] = domainWarehouseStock(&m)
+	}
+
+	return stocks
+}
+
+func domainWarehouseStock(model *WarehouseStock) domain.WarehouseStock {
+	return domain.WarehouseStock{
+		WarehouseID:   model.WarehouseID,
+		WarehouseCode: model.Warehouse.Code,
+		WarehouseName: model.Warehouse.Name,
+		StockNumber:   model.StockNumber,
+	}
+}
+
+func domainStockTransfer(model *StockTransfer) *domain.StockTransfer {
+	return &domain.StockTransfer{
+		ID:              model.ID,
+		ProductID:       model.ProductID,
+		FromWarehouseID: model.FromWarehouseID,
+		ToWarehouseID:   model.ToWarehouseID,
+		Quantity:        model.Quantity,
+		Status:          domain.TransferStatus(model.Status),
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
+
+func domainMainCategories(models []*MainCategory) []*domain.MainCategory {
+	categories := make([]*domain.MainCategory, len(models))
+	for i, m := range models {
+		subCategories := make([]*domain.SubCategory, len(m.SubCategories))
+		for j, sc := range m.SubCategories {
+			subCategories[j] = &domain.SubCategory{
+				ID:           sc.ID,
+				MainCategory: m.Name,
+				Name:         sc.Name,
+			}
+		}
+
+		categories[i] = &domain.MainCategory{
+			ID:            m.ID,
+			Name:          m.Name,
+			SubCategories: subCategories,
+		}
+	}
+
+	return categories
+}
+
+func domainCurrencies(models []*Currency) []*domain.Currency {
+	currencies := make([]*domain.Currency, len(models))
+	for i, m := range models {
+		currencies[i] = domainCurrency(m)
+	}
+
+	return currencies
+}
+
+func domainCurrency(model *Currency) *domain.Currency {
+	return &domain.Currency{
+		ID:       model.ID,
+		Code:     model.Code,
+		Symbol:   model.Symbol,
+		Disabled: model.Disabled,
+	}
+}
+
+func domainExchangeRate(model *ExchangeRate) *domain.ExchangeRate {
+	return &domain.ExchangeRate{
+		BaseCurrency:  model.BaseCurrency.Code,
+		QuoteCurrency: model.QuoteCurrency.Code,
+		Rate:          model.Rate,
+		EffectiveDate: model.EffectiveDate,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func domainProductSnapshot(h *ProductHistory) (*domain.ProductSnapshot, error) {
+	product := &domain.Product{}
+	err := json.Unmarshal([]byte(h.Snapshot), product)
+	if err != nil {
+		return nil, fmt.Errorf("decode snapshot id=%d: %w", h.ID, err)
+	}
+
+	return &domain.ProductSnapshot{
+		ProductID: h.ProductID,
+		Version:   h.Version,
+		Change:    domain.ProductChange(h.Change),
+		Actor:     h.Actor,
+		ChangedAt: h.ChangedAt,
+		Product:   product,
+	}, nil
+}
+
+func domainEvents(models []*OutboxEvent) []*domain.Event {
+	events := make([]*domain.Event, len(models))
+	for i, m := range models {
+		events[i] = &domain.Event{
+			ID:         m.ID,
+			Type:       domain.EventType(m.Type),
+			ProductID:  m.ProductID,
+			Payload:    json.RawMessage(m.Payload),
+			OccurredAt: m.CreatedAt,
+		}
 	}
+
+	return events
+}
+
+func domainSupplier(model *Supplier) *domain.Supplier {
+	return &domain.Supplier{
+		ID:        model.ID,
+		Name:      model.Name,
+		Email:     model.Email,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func domainPurchaseOrder(model *PurchaseOrder) *domain.PurchaseOrder {
+	lines := make([]domain.PurchaseOrderLine, len(model.Lines))
+	for i, l := range model.Lines {
+		lines[i] = domain.PurchaseOrderLine{
+			ID:               l.ID,
+			ProductID:        l.ProductID,
+			Quantity:         l.Quantity,
+			ReceivedQuantity: l.ReceivedQuantity,
+			UnitCost:         l.UnitCost,
+		}
+	}
+
+	return &domain.PurchaseOrder{
+		ID:           model.ID,
+		SupplierID:   model.SupplierID,
+		CurrencyCode: model.Currency.Code,
+		Status:       domain.PurchaseOrderStatus(model.Status),
+		Reference:    model.Reference,
+		ExpectedAt:   model.ExpectedAt,
+		Lines:        lines,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
+
+func domainProductSuppliers(models []ProductSupplier) []*domain.ProductSupplier {
+	links := make([]*domain.ProductSupplier, len(models))
+	for i, m := range models {
+		links[i] = &domain.ProductSupplier{
+			ProductID:    m.ProductID,
+			SupplierID:   m.SupplierID,
+			SupplierName: m.Supplier.Name,
+			SupplierSKU:  m.SupplierSKU,
+			UnitCost:     m.UnitCost,
+			CurrencyCode: m.Currency.Code,
+			LeadTimeDays: m.LeadTimeDays,
+			Preferred:    m.Preferred,
+			CreatedAt:    m.CreatedAt,
+			UpdatedAt:    m.UpdatedAt,
+		}
+	}
+
+	return links
+}
+
+func domainCostLayers(models []CostLayer) []*domain.CostLayer {
+	layers := make([]*domain.CostLayer, len(models))
+	for i, m := range models {
+		layers[i] = &domain.CostLayer{
+			ID:                m.ID,
+			ProductID:         m.ProductID,
+			CurrencyCode:      m.Currency.Code,
+			Quantity:          m.Quantity,
+			RemainingQuantity: m.RemainingQuantity,
+			UnitCost:          m.UnitCost,
+			Reference:         m.Reference,
+			CreatedAt:         m.CreatedAt,
+		}
+	}
+
+	return layers
 }
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// productChangesChannel is notified with the id of every event added to the
+// outbox, once its transaction commits.
+const productChangesChannel = "product_changes"
+
+type productChangeRow struct {
+	OutboxEvent
+	MainCategory string
+	SubCategory  string
+}
+
+// ListenProductChanges calls fn with every product change committed while
+// it listens, until ctx is done or the connection fails. Changes committed
+// before it listens, or while it reconnects, are not replayed.
+func (a *Adapter) ListenProductChanges(ctx context.Context, fn func(*domain.ProductChangeEvent)) error {
+	sqlDB, err := a.db.DB()
+	if err != nil {
+		return fmt.Errorf("get *sql.DB: %w", err)
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) (err error) {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+
+		_, err = pgxConn.Exec(ctx, "LISTEN "+productChangesChannel)
+		if err != nil {
+			return fmt.Errorf("listen to %s: %w", productChangesChannel, err)
+		}
+		defer func() {
+			_, unlistenErr := pgxConn.Exec(context.Background(), "UNLISTEN "+productChangesChannel)
+			if unlistenErr != nil {
+				err = errors.Join(err, fmt.Errorf("unlisten %s: %w", productChangesChannel, unlistenErr))
+			}
+		}()
+
+		for {
+			n, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				return fmt.Errorf("wait for product change: %w", err)
+			}
+
+			id, err := strconv.ParseInt(n.Payload, 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse product change %q: %w", n.Payload, err)
+			}
+
+			event, err := a.productChangeEvent(ctx, id)
+			if err != nil {
+				return err
+			}
+			fn(event)
+		}
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// productChangeEvent reads the outbox event with the categories its product
+// has now, if it has not been purged.
+func (a *Adapter) productChangeEvent(ctx context.Context, id int64) (*domain.ProductChangeEvent, error) {
+	db := a.db.WithContext(ctx)
+
+	var row productChangeRow
+	err := db.Table("outbox_events").
+		Select("outbox_events.*", "main_categories.name AS main_category", "sub_categories.name AS sub_category").
+		Joins("LEFT JOIN products ON products.id = outbox_events.product_id").
+		Joins("LEFT JOIN sub_categories ON sub_categories.id = products.sub_category_id").
+		Joins("LEFT JOIN main_categories ON main_categories.id = sub_categories.main_category_id").
+		Where("outbox_events.id = ?", id).
+		Take(&row).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select outbox event id=%d: %w", id, err)
+	}
+
+	return &domain.ProductChangeEvent{
+		Event:        *domainEvents([]*OutboxEvent{&row.OutboxEvent})[0],
+		MainCategory: row.MainCategory,
+		SubCategory:  row.SubCategory,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// insertOutboxEvents adds the events to the outbox in the transaction of the
+// change they describe, so that no change goes unpublished and no event is
+// published for a change that was rolled back.
+func insertOutboxEvents(tx *gorm.DB, events []*OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	err := tx.Create(&events).Error
+	if err != nil {
+		return fmt.Errorf("insert outbox events: %w", err)
+	}
+
+	return nil
+}
+
+// recordStockEvents adds a stock changed event for each ledger entry.
+func recordStockEvents(tx *gorm.DB, movements []*StockMovement) error {
+	events := make([]*OutboxEvent, len(movements))
+	for i, m := range movements {
+		payload, err := json.Marshal(domainStockMovement(m))
+		if err != nil {
+			return fmt.Errorf("encode stock movement of product id=%d: %w", m.ProductID, err)
+		}
+
+		events[i] = &OutboxEvent{
+			Type:      string(domain.EventStockChanged),
+			ProductID: m.ProductID,
+			Payload:   string(payload),
+		}
+	}
+
+	return insertOutboxEvents(tx, events)
+}
+
+// RelayEvents hands up to limit unpublished events, oldest first, to publish
+// and marks them published once it returns without error. The events stay
+// locked meanwhile, so concurrent relays publish disjoint batches.
+func (a *Adapter) RelayEvents(ctx context.Context, limit int, publish func([]*domain.Event) error) (int64, error) {
+	return a.relayEvents(ctx, "published_at", limit, publish)
+}
+
+// NotifyEvents hands up to limit low stock events not yet notified, oldest
+// first, to notify and marks them notified once it returns without error.
+// It is tracked apart from RelayEvents, so that a failing notifier does not
+// hold back the publisher, nor the other way round.
+func (a *Adapter) NotifyEvents(ctx context.Context, limit int, notify func([]*domain.Event) error) (int64, error) {
+	lowStock := func(db *gorm.DB) *gorm.DB {
+		return db.Where("type = ?", string(domain.EventStockLow))
+	}
+
+	return a.relayEvents(ctx, "notified_at", limit, notify, lowStock)
+}
+
+// relayEvents hands the events whose column is not set yet to deliver, and
+// sets it once deliver returns without error.
+func (a *Adapter) relayEvents(ctx context.Context, column string, limit int, deliver func([]*domain.Event) error, scopes ...func(*gorm.DB) *gorm.DB) (n int64, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	var events []*OutboxEvent
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Scopes(scopes...).
+		Where(column + " IS NULL").
+		Order("id").
+		Limit(limit).
+		Find(&events).
+		Error
+	if err != nil {
+		return 0, fmt.Errorf("select events by %s: %w", column, err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	err = deliver(domainEvents(events))
+	if err != nil {
+		return 0, err
+	}
+
+	ids := make([]int64, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	err = tx.Model(&OutboxEvent{}).Where("id IN ?", ids).Update(column, time.Now()).Error
+	if err != nil {
+		return 0, fmt.Errorf("update %s of events: %w", column, err)
+	}
+
+	return int64(len(events)), nil
+}
+
+// PurgeOutboxEvents deletes up to limit events created before the given
+// time, oldest first, once published and, for low stock events, notified.
+// The unpublished events are deleted as well when unpublished is true, and
+// the low stock events not notified when unnotified is true.
+func (a *Adapter) PurgeOutboxEvents(ctx context.Context, before time.Time, unpublished, unnotified bool, limit int) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	// Rows locked by a running relay are skipped rather than waited on.
+	ids := db.Model(&OutboxEvent{}).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Select("id").
+		Where("created_at <= ?", before).
+		Order("id").
+		Limit(limit)
+	if !unpublished {
+		ids = ids.Where("published_at IS NOT NULL")
+	}
+	if !unnotified {
+		ids = ids.Where("type <> ? OR notified_at IS NOT NULL", string(domain.EventStockLow))
+	}
+
+	res := db.Where("id IN (?)", ids).Delete(&OutboxEvent{})
+	if err := res.Error; err != nil {
+		return 0, fmt.Errorf("delete outbox events: %w", err)
+	}
+
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (id int64, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	crcID, err := getCurrencyIDByCode(tx, req.CurrencyCode)
+	if err != nil {
+		return 0, err
+	}
+
+	po := &PurchaseOrder{
+		SupplierID: req.SupplierID,
+		CurrencyID: crcID,
+		Status:     string(domain.PurchaseOrderOpen),
+		Reference:  req.Reference,
+		ExpectedAt: req.ExpectedAt,
+		Lines:      make([]PurchaseOrderLine, len(req.Lines)),
+	}
+	for i, l := range req.Lines {
+		po.Lines[i] = PurchaseOrderLine{
+			ProductID: l.ProductID,
+			Quantity:  l.Quantity,
+			UnitCost:  l.UnitCost,
+		}
+	}
+
+	err = tx.Omit("Supplier", "Currency").Create(po).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return 0, domain.ErrAssociationNotFound
+		default:
+			return 0, fmt.Errorf("insert purchase order: %w", err)
+		}
+	}
+
+	return po.ID, nil
+}
+
+func (a *Adapter) GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error) {
+	return getPurchaseOrder(a.db.WithContext(ctx), id)
+}
+
+func getPurchaseOrder(db *gorm.DB, id int64) (*domain.PurchaseOrder, error) {
+	po := &PurchaseOrder{}
+	err := db.Joins("Currency").Preload("Lines", orderByID).First(po, id).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select purchase order by id=%d: %w", id, err)
+		}
+	}
+
+	return domainPurchaseOrder(po), nil
+}
+
+// ReceiveGoods adds the received units of each line to the product stock,
+// and to the warehouse stock when a warehouse is given, then moves the order
+// to received once every line is complete. The units are costed at the unit
+// cost of their line with the given method. It fails with
+// domain.ErrOverReceipt when a line would receive more than was ordered.
+func (a *Adapter) ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest, method domain.CostMethod) (po *domain.PurchaseOrder, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	order, err := lockOpenPurchaseOrder(tx, req.PurchaseOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var warehouseID *int64
+	if req.WarehouseID != 0 {
+		err = tx.First(&Warehouse{}, req.WarehouseID).Error
+		if err != nil {
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return nil, domain.ErrAssociationNotFound
+			default:
+				return nil, fmt.Errorf("select warehouse by id=%d: %w", req.WarehouseID, err)
+			}
+		}
+		warehouseID = &req.WarehouseID
+	}
+
+	lines := make(map[int64]*PurchaseOrderLine, len(order.Lines))
+	for i := range order.Lines {
+		lines[order.Lines[i].ID] = &order.Lines[i]
+	}
+
+	for _, r := range req.Lines {
+		l, ok := lines[r.LineID]
+		if !ok {
+			return nil, domain.ErrNotFound
+		}
+		if l.ReceivedQuantity+r.Quantity > l.Quantity {
+			return nil, domain.ErrOverReceipt
+		}
+
+		l.ReceivedQuantity += r.Quantity
+		err = tx.Model(l).Update("received_quantity", l.ReceivedQuantity).Error
+		if err != nil {
+			return nil, fmt.Errorf("update purchase order line id=%d: %w", l.ID, err)
+		}
+
+		_, err = changeStock(tx, &StockMovement{
+			ProductID:   l.ProductID,
+			WarehouseID: warehouseID,
+			Reason:      string(domain.MovementReceipt),
+			Quantity:    r.Quantity,
+			Reference:   purchaseOrderReference(order.ID),
+			costed:      true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = addCostLayer(tx, &CostLayer{
+			ProductID:  l.ProductID,
+			CurrencyID: order.CurrencyID,
+			Quantity:   r.Quantity,
+			UnitCost:   &l.UnitCost,
+			Reference:  purchaseOrderReference(order.ID),
+		}, method)
+		if err != nil {
+			return nil, err
+		}
+
+		if warehouseID != nil {
+			_, err = changeWarehouseStock(tx, l.ProductID, *warehouseID, r.Quantity)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	status := domain.PurchaseOrderReceived
+	for _, l := range order.Lines {
+		if l.ReceivedQuantity < l.Quantity {
+			status = domain.PurchaseOrderPartiallyReceived
+			break
+		}
+	}
+
+	err = updatePurchaseOrderStatus(tx, order, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPurchaseOrder(tx, order.ID)
+}
+
+// CancelPurchaseOrder gives up on the units not received yet. The units
+// already received stay in stock.
+func (a *Adapter) CancelPurchaseOrder(ctx context.Context, id int64) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	order, err := lockOpenPurchaseOrder(tx, id)
+	if err != nil {
+		return err
+	}
+
+	return updatePurchaseOrderStatus(tx, order, domain.PurchaseOrderCancelled)
+}
+
+// lockOpenPurchaseOrder locks the order for the rest of the transaction and
+// loads its lines. It fails with domain.ErrEditConflict when the order was
+// closed concurrently.
+func lockOpenPurchaseOrder(tx *gorm.DB, id int64) (*PurchaseOrder, error) {
+	po := &PurchaseOrder{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Lines", orderByID).
+		First(po, id).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select purchase order by id=%d: %w", id, err)
+		}
+	}
+
+	if !domain.PurchaseOrderStatus(po.Status).IsOpen() {
+		return nil, domain.ErrEditConflict
+	}
+
+	return po, nil
+}
+
+func updatePurchaseOrderStatus(tx *gorm.DB, po *PurchaseOrder, status domain.PurchaseOrderStatus) error {
+	err := tx.Model(po).Update("status", string(status)).Error
+	if err != nil {
+		return fmt.Errorf("update status of purchase order id=%d: %w", po.ID, err)
+	}
+
+	return nil
+}
+
+func purchaseOrderReference(id int64) string {
+	return fmt.Sprintf("purchase_order:%d", id)
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (reservation *domain.Reservation, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	_, err = changeStock(tx, &StockMovement{
+		ProductID: req.ProductID,
+		Reason:    string(domain.MovementReservation),
+		Quantity:  -req.Quantity,
+		Reference: req.OrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reservation{
+		ProductID: req.ProductID,
+		OrderID:   req.OrderID,
+		Quantity:  req.Quantity,
+		Status:    string(domain.ReservationHeld),
+		ExpiresAt: time.Now().Add(req.TTL),
+	}
+	err = tx.Omit(clause.Associations).Create(r).Error
+	if err != nil {
+		return nil, fmt.Errorf("insert reservation: %w", err)
+	}
+
+	return domainReservation(r), nil
+}
+
+func (a *Adapter) GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error) {
+	db := a.db.WithContext(ctx)
+
+	var reservations []*Reservation
+	err := db.Where("order_id = ?", orderID).Order("id").Find(&reservations).Error
+	if err != nil {
+		return nil, fmt.Errorf("select reservations by order id=%s: %w", orderID, err)
+	}
+
+	return domainReservations(reservations), nil
+}
+
+func (a *Adapter) ConfirmReservation(ctx context.Context, orderID string) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	reservations, err := lockHeldReservations(tx, orderID)
+	if err != nil {
+		return err
+	}
+	if len(reservations) == 0 {
+		return domain.ErrNotFound
+	}
+
+	now := time.Now()
+	for _, r := range reservations {
+		if !r.ExpiresAt.After(now) {
+			return domain.ErrReservationExpired
+		}
+	}
+
+	// The held units only leave the cost layers once the order is confirmed.
+	for _, r := range reservations {
+		err = consumeCostLayers(tx, r.ProductID, r.Quantity)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Model(&Reservation{}).
+		Where("order_id = ?", orderID).
+		Where("status = ?", string(domain.ReservationHeld)).
+		Update("status", string(domain.ReservationConfirmed)).
+		Error
+	if err != nil {
+		return fmt.Errorf("confirm reservations of order id=%s: %w", orderID, err)
+	}
+
+	return nil
+}
+
+func (a *Adapter) ReleaseReservation(ctx context.Context, orderID string) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	reservations, err := lockHeldReservations(tx, orderID)
+	if err != nil {
+		return err
+	}
+	if len(reservations) == 0 {
+		return domain.ErrNotFound
+	}
+
+	return releaseReservations(tx, reservations, domain.ReservationReleased)
+}
+
+func (a *Adapter) ReleaseExpiredReservations(ctx context.Context, before time.Time, limit int) (n int64, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	// Rows locked by a concurrent sweeper are skipped rather than waited on.
+	var reservations []*Reservation
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ?", string(domain.ReservationHeld)).
+		Where("expires_at <= ?", before).
+		Order("id").
+		Limit(limit).
+		Find(&reservations).
+		Error
+	if err != nil {
+		return 0, fmt.Errorf("select expired reservations: %w", err)
+	}
+
+	err = releaseReservations(tx, reservations, domain.ReservationExpired)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(reservations)), nil
+}
+
+func lockHeldReservations(tx *gorm.DB, orderID string) ([]*Reservation, error) {
+	var reservations []*Reservation
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		Where("status = ?", string(domain.ReservationHeld)).
+		Order("id").
+		Find(&reservations).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select held reservations: %w", err)
+	}
+
+	return reservations, nil
+}
+
+// releaseReservations gives the held units back to the stock of their
+// products and moves the reservations to the given status.
+func releaseReservations(tx *gorm.DB, reservations []*Reservation, status domain.ReservationStatus) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(reservations))
+	for i, r := range reservations {
+		_, err := changeStock(tx, &StockMovement{
+			ProductID: r.ProductID,
+			Reason:    string(domain.MovementRelease),
+			Quantity:  r.Quantity,
+			Reference: r.OrderID,
+		})
+		if err != nil {
+			return fmt.Errorf("restock product id=%d: %w", r.ProductID, err)
+		}
+		ids[i] = r.ID
+	}
+
+	err := tx.Model(&Reservation{}).Where("id IN ?", ids).Update("status", string(status)).Error
+	if err != nil {
+		return fmt.Errorf("update reservation status: %w", err)
+	}
+
+	return nil
+}
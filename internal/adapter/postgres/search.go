@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// SearchProducts matches products whose name contains words starting with
+// every term of query, most relevant first. The search_vector column and its
+// GIN index are created by the baseline migration.
+func (a *Adapter) SearchProducts(ctx context.Context, query string, filter domain.Filter) (int64, []*domain.Product, error) {
+	db := a.db.WithContext(ctx)
+
+	tsQuery := prefixTSQuery(query)
+	if tsQuery == "" {
+		return 0, []*domain.Product{}, nil
+	}
+
+	var products []*Product
+	q := filterProducts(db.Model(&products), filter).
+		Where("products.search_vector @@ to_tsquery('simple', ?)", tsQuery)
+
+	var total int64 = 0
+	err := q.Count(&total).Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("count products matching %q: %w", query, err)
+	}
+	if total > 0 {
+		err := q.Joins("SubCategory.MainCategory").Joins("Currency").
+			Preload("WarehouseStocks", orderByWarehouse).
+			Preload("WarehouseStocks.Warehouse").
+			Preload("Variants", orderByID).
+			Clauses(clause.OrderBy{Expression: clause.Expr{
+				SQL:                "ts_rank(products.search_vector, to_tsquery('simple', ?)) DESC, products.id",
+				Vars:               []any{tsQuery},
+				WithoutParentheses: true,
+			}}).
+			Limit(filter.Limit()).
+			Offset(int(filter.Offset())).
+			Find(&products).
+			Error
+		if err != nil {
+			return 0, nil, fmt.Errorf("select products matching %q: %w", query, err)
+		}
+	}
+
+	return total, domainProducts(products), nil
+}
+
+// prefixTSQuery turns free text into a tsquery matching every word as a
+// prefix, e.g. "super man" becomes "super:* & man:*". Characters other than
+// letters and digits only separate words, so user input cannot inject
+// tsquery operators.
+func prefixTSQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, len(words))
+	for i, w := range words {
+		terms[i] = w + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SeedData is the reference data a database needs before products can be
+// created.
+type SeedData struct {
+	Currencies []SeedCurrency `yaml:"currencies" json:"currencies"`
+	Categories []SeedCategory `yaml:"categories" json:"categories"`
+}
+
+type SeedCurrency struct {
+	Code   string `yaml:"code" json:"code"`
+	Symbol string `yaml:"symbol" json:"symbol"`
+}
+
+type SeedCategory struct {
+	Name          string   `yaml:"name" json:"name"`
+	SubCategories []string `yaml:"sub_categories" json:"sub_categories"`
+}
+
+// SeedResult counts the rows written by Seed, inserted or updated alike.
+type SeedResult struct {
+	Currencies     int
+	MainCategories int
+	SubCategories  int
+}
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Validate reports the first entry that cannot be seeded.
+func (d *SeedData) Validate() error {
+	codes := make(map[string]bool)
+	for _, c := range d.Currencies {
+		if !currencyCodePattern.MatchString(c.Code) {
+			return fmt.Errorf("currency code %q is not an ISO 4217 code", c.Code)
+		}
+		if c.Symbol == "" {
+			return fmt.Errorf("currency %s has no symbol", c.Code)
+		}
+		if codes[c.Code] {
+			return fmt.Errorf("currency %s is listed twice", c.Code)
+		}
+		codes[c.Code] = true
+	}
+
+	mains := make(map[string]bool)
+	subs := make(map[string]string)
+	for _, c := range d.Categories {
+		if c.Name == "" {
+			return fmt.Errorf("category has no name")
+		}
+		if mains[c.Name] {
+			return fmt.Errorf("category %q is listed twice", c.Name)
+		}
+		mains[c.Name] = true
+
+		for _, sc := range c.SubCategories {
+			if sc == "" {
+				return fmt.Errorf("category %q has a subcategory with no name", c.Name)
+			}
+			if main, ok := subs[sc]; ok {
+				return fmt.Errorf("subcategory %q is listed under both %q and %q", sc, main, c.Name)
+			}
+			subs[sc] = c.Name
+		}
+	}
+
+	return nil
+}
+
+// Seed upserts currencies by code and categories by name in one transaction,
+// so it can be run again after the seed file changes. A subcategory listed
+// under another main category than the stored one is moved there. Nothing is
+// ever deleted.
+func (a *Adapter) Seed(ctx context.Context, data *SeedData) (result SeedResult, err error) {
+	err = data.Validate()
+	if err != nil {
+		return SeedResult{}, err
+	}
+
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	for _, c := range data.Currencies {
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"symbol", "updated_at"}),
+		}).Create(&Currency{Code: c.Code, Symbol: c.Symbol}).Error
+		if err != nil {
+			return SeedResult{}, fmt.Errorf("upsert currency %s: %w", c.Code, err)
+		}
+		result.Currencies++
+	}
+
+	for _, c := range data.Categories {
+		mc := &MainCategory{Name: c.Name}
+		err = upsertByName(tx, mc)
+		if err != nil {
+			return SeedResult{}, fmt.Errorf("upsert main category %q: %w", c.Name, err)
+		}
+		result.MainCategories++
+
+		for _, name := range c.SubCategories {
+			sc := &SubCategory{Name: name, MainCategoryID: mc.ID}
+			err = upsertByName(tx.Omit(clause.Associations), sc, "main_category_id")
+			if err != nil {
+				return SeedResult{}, fmt.Errorf("upsert subcategory %q: %w", name, err)
+			}
+			result.SubCategories++
+		}
+	}
+
+	return result, nil
+}
+
+// upsertByName inserts the row or updates the given columns of the row with
+// the same name, and fills in its id either way.
+func upsertByName(tx *gorm.DB, row any, columns ...string) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns(append(columns, "updated_at")),
+	}).Create(row).Error
+}
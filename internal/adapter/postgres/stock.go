@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// stockLevel is the stock of a product after a change, with what a low
+// stock alert needs to know about it.
+type stockLevel struct {
+	ProductID       int64 `gorm:"column:id"`
+	StockNumber     int
+	Version         int64
+	SKU             *string `gorm:"column:sku"`
+	Name            string
+	ReorderPoint    *int
+	ReorderQuantity int
+}
+
+var stockLevelColumns = []string{"id", "stock_number", "version", "sku", "name", "reorder_point", "reorder_quantity"}
+
+// changeStock adds m.Quantity to the stock number of m.ProductID in a single
+// statement, bumps the product version and records m in the stock ledger.
+// The stock number never drops below zero.
+func changeStock(tx *gorm.DB, m *StockMovement) (stockLevel, error) {
+	var level stockLevel
+	res := tx.Raw(`UPDATE products
+		SET stock_number = stock_number + @delta, version = version + 1, updated_at = now()
+		WHERE id = @id AND deleted_at IS NULL AND stock_number + @delta >= 0
+		RETURNING `+strings.Join(stockLevelColumns, ", "),
+		map[string]any{"id": m.ProductID, "delta": m.Quantity},
+	).Scan(&level)
+	if err := res.Error; err != nil {
+		return stockLevel{}, fmt.Errorf("update stock of product id=%d: %w", m.ProductID, err)
+	}
+
+	if res.RowsAffected == 0 {
+		var found bool
+		err := tx.Model(&Product{}).Select("count(*) > 0").Where("id = ?", m.ProductID).Take(&found).Error
+		if err != nil {
+			return stockLevel{}, fmt.Errorf("select product by id=%d: %w", m.ProductID, err)
+		}
+		if !found {
+			return stockLevel{}, domain.ErrNotFound
+		}
+
+		return stockLevel{}, domain.ErrInsufficientStock
+	}
+
+	m.StockAfter = level.StockNumber
+	err := insertStockMovement(tx, m)
+	if err != nil {
+		return stockLevel{}, err
+	}
+
+	err = recordLowStock(tx, level, level.StockNumber-m.Quantity)
+	if err != nil {
+		return stockLevel{}, err
+	}
+
+	return level, nil
+}
+
+// recordLowStock adds a low stock event to the outbox when the stock of the
+// product went from before to level across its reorder point.
+func recordLowStock(tx *gorm.DB, level stockLevel, before int) error {
+	if !domain.CrossesReorderPoint(level.ReorderPoint, before, level.StockNumber) {
+		return nil
+	}
+
+	e, err := lowStockEvent(level)
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxEvents(tx, []*OutboxEvent{e})
+}
+
+func lowStockEvent(level stockLevel) (*OutboxEvent, error) {
+	payload, err := json.Marshal(domain.LowStockAlert{
+		ProductID:       level.ProductID,
+		SKU:             stringValue(level.SKU),
+		Name:            level.Name,
+		StockNumber:     level.StockNumber,
+		ReorderPoint:    *level.ReorderPoint,
+		ReorderQuantity: level.ReorderQuantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode low stock alert of product id=%d: %w", level.ProductID, err)
+	}
+
+	return &OutboxEvent{
+		Type:      string(domain.EventStockLow),
+		ProductID: level.ProductID,
+		Payload:   string(payload),
+	}, nil
+}
+
+// recordInitialStock records the opening stock of newly created products as
+// receipts, and raises a low stock alert for the products created at or
+// below their reorder point.
+func recordInitialStock(tx *gorm.DB, products []*Product) error {
+	var movements []*StockMovement
+	var alerts []*OutboxEvent
+	for _, p := range products {
+		if p.StockNumber > 0 {
+			movements = append(movements, &StockMovement{
+				ProductID:  p.ID,
+				Reason:     string(domain.MovementReceipt),
+				Quantity:   p.StockNumber,
+				StockAfter: p.StockNumber,
+			})
+		}
+
+		if p.ReorderPoint != nil && p.StockNumber <= *p.ReorderPoint {
+			e, err := lowStockEvent(stockLevel{
+				ProductID:       p.ID,
+				StockNumber:     p.StockNumber,
+				Version:         p.Version,
+				SKU:             p.SKU,
+				Name:            p.Name,
+				ReorderPoint:    p.ReorderPoint,
+				ReorderQuantity: p.ReorderQuantity,
+			})
+			if err != nil {
+				return err
+			}
+			alerts = append(alerts, e)
+		}
+	}
+
+	if len(movements) > 0 {
+		err := insertStockMovements(tx, movements)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(alerts) > 0 {
+		return insertOutboxEvents(tx, alerts)
+	}
+
+	return nil
+}
+
+func insertStockMovement(tx *gorm.DB, m *StockMovement) error {
+	return insertStockMovements(tx, []*StockMovement{m})
+}
+
+// insertStockMovements appends the movements to the stock ledger, keeps the
+// cost layers in line with them and records their stock events.
+func insertStockMovements(tx *gorm.DB, movements []*StockMovement) error {
+	err := tx.Create(&movements).Error
+	if err != nil {
+		return fmt.Errorf("insert stock movements: %w", err)
+	}
+
+	for _, m := range movements {
+		err = applyCostLayers(tx, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return recordStockEvents(tx, movements)
+}
+
+func (a *Adapter) AdjustStock(ctx context.Context, req *domain.AdjustStockRequest) (level *domain.StockLevel, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	l, err := changeStock(tx, &StockMovement{
+		ProductID: req.ProductID,
+		Reason:    string(req.Reason),
+		Quantity:  req.Delta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return domainStockLevel(req.ProductID, l), nil
+}
+
+func (a *Adapter) GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) (int64, []*domain.StockMovement, error) {
+	db := a.db.WithContext(ctx)
+
+	var movements []*StockMovement
+	query := db.Model(&movements).Where("product_id = ?", productID)
+
+	var total int64 = 0
+	err := query.Count(&total).Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("count stock movements: %w", err)
+	}
+	if total > 0 {
+		err := query.Order("id DESC").
+			Limit(filter.Limit()).
+			Offset(int(filter.Offset())).
+			Find(&movements).
+			Error
+		if err != nil {
+			return 0, nil, fmt.Errorf("select stock movements: %w", err)
+		}
+	}
+
+	return total, domainStockMovements(movements), nil
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) GetSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	db := a.db.WithContext(ctx)
+
+	var suppliers []*Supplier
+	err := db.Order("name").Find(&suppliers).Error
+	if err != nil {
+		return nil, fmt.Errorf("select suppliers: %w", err)
+	}
+
+	res := make([]*domain.Supplier, len(suppliers))
+	for i, s := range suppliers {
+		res[i] = domainSupplier(s)
+	}
+
+	return res, nil
+}
+
+func (a *Adapter) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	s := &Supplier{
+		Name:  req.Name,
+		Email: req.Email,
+	}
+	err := db.Create(s).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		default:
+			return 0, fmt.Errorf("insert supplier: %w", err)
+		}
+	}
+
+	return s.ID, nil
+}
+
+func (a *Adapter) GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error) {
+	db := a.db.WithContext(ctx)
+
+	s := &Supplier{}
+	err := db.First(s, id).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select supplier by id=%d: %w", id, err)
+		}
+	}
+
+	return domainSupplier(s), nil
+}
+
+func (a *Adapter) UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Model(&Supplier{}).Where("id = ?", req.ID).Updates(map[string]any{
+		"name":  req.Name,
+		"email": req.Email,
+	})
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return domain.ErrAlreadyExists
+		default:
+			return fmt.Errorf("update supplier id=%d: %w", req.ID, err)
+		}
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteSupplier removes the supplier and its product links. It fails with
+// domain.ErrInUse while purchase orders refer to the supplier.
+func (a *Adapter) DeleteSupplier(ctx context.Context, id int64) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Delete(&Supplier{}, id)
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return domain.ErrInUse
+		default:
+			return fmt.Errorf("delete supplier id=%d: %w", id, err)
+		}
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (a *Adapter) GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error) {
+	db := a.db.WithContext(ctx)
+
+	var found bool
+	err := db.Model(&Product{}).Select("count(*) > 0").Where("id = ?", productID).Take(&found).Error
+	if err != nil {
+		return nil, fmt.Errorf("select product by id=%d: %w", productID, err)
+	}
+	if !found {
+		return nil, domain.ErrNotFound
+	}
+
+	var links []ProductSupplier
+	err = db.Joins("Supplier").Joins("Currency").
+		Where("product_suppliers.product_id = ?", productID).
+		Order("product_suppliers.preferred DESC").
+		Order("product_suppliers.lead_time_days").
+		Order("product_suppliers.supplier_id").
+		Find(&links).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("select suppliers of product id=%d: %w", productID, err)
+	}
+
+	return domainProductSuppliers(links), nil
+}
+
+// SetProductSupplier inserts or replaces the link between a product and a
+// supplier. It fails with domain.ErrAssociationNotFound when the product,
+// the supplier or the currency does not exist.
+func (a *Adapter) SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	crcID, err := getCurrencyIDByCode(tx, req.CurrencyCode)
+	if err != nil {
+		return err
+	}
+
+	if req.Preferred {
+		// The product row is locked first, so that concurrent calls making
+		// another supplier preferred wait for each other instead of both
+		// unsetting the old one and clashing on the preferred index.
+		err = tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", req.ProductID).
+			Take(&Product{}).
+			Error
+		if err != nil {
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return domain.ErrAssociationNotFound
+			default:
+				return fmt.Errorf("lock product id=%d: %w", req.ProductID, err)
+			}
+		}
+
+		err = tx.Model(&ProductSupplier{}).
+			Where("product_id = ? AND supplier_id <> ? AND preferred", req.ProductID, req.SupplierID).
+			Update("preferred", false).
+			Error
+		if err != nil {
+			return fmt.Errorf("unset preferred supplier of product id=%d: %w", req.ProductID, err)
+		}
+	}
+
+	link := &ProductSupplier{
+		ProductID:    req.ProductID,
+		SupplierID:   req.SupplierID,
+		SupplierSKU:  req.SupplierSKU,
+		UnitCost:     req.UnitCost,
+		CurrencyID:   crcID,
+		LeadTimeDays: req.LeadTimeDays,
+		Preferred:    req.Preferred,
+	}
+	err = tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "product_id"}, {Name: "supplier_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"supplier_sku", "unit_cost", "currency_id", "lead_time_days", "preferred", "updated_at",
+		}),
+	}).Create(link).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return domain.ErrAssociationNotFound
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return domain.ErrEditConflict
+		default:
+			return fmt.Errorf("upsert supplier id=%d of product id=%d: %w", req.SupplierID, req.ProductID, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *Adapter) RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error {
+	db := a.db.WithContext(ctx)
+
+	res := db.Where("product_id = ? AND supplier_id = ?", req.ProductID, req.SupplierID).Delete(&ProductSupplier{})
+	if err := res.Error; err != nil {
+		return fmt.Errorf("delete supplier id=%d of product id=%d: %w", req.SupplierID, req.ProductID, err)
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
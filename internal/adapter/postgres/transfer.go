@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	t := &StockTransfer{
+		ProductID:       req.ProductID,
+		FromWarehouseID: req.FromWarehouseID,
+		ToWarehouseID:   req.ToWarehouseID,
+		Quantity:        req.Quantity,
+		Status:          string(domain.TransferRequested),
+	}
+	err := db.Omit(clause.Associations).Create(t).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrForeignKeyViolated):
+			return 0, domain.ErrAssociationNotFound
+		default:
+			return 0, fmt.Errorf("insert stock transfer: %w", err)
+		}
+	}
+
+	return t.ID, nil
+}
+
+func (a *Adapter) GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error) {
+	db := a.db.WithContext(ctx)
+
+	t := &StockTransfer{}
+	err := db.First(t, id).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select stock transfer by id=%d: %w", id, err)
+		}
+	}
+
+	return domainStockTransfer(t), nil
+}
+
+// DispatchStockTransfer takes the transferred units out of the source
+// warehouse and out of the product stock while they are in transit.
+func (a *Adapter) DispatchStockTransfer(ctx context.Context, id int64) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	t, err := lockStockTransfer(tx, id, domain.TransferRequested)
+	if err != nil {
+		return err
+	}
+
+	_, err = changeStock(tx, &StockMovement{
+		ProductID:   t.ProductID,
+		WarehouseID: &t.FromWarehouseID,
+		Reason:      string(domain.MovementTransfer),
+		Quantity:    -t.Quantity,
+		Reference:   transferReference(t.ID),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = changeWarehouseStock(tx, t.ProductID, t.FromWarehouseID, -t.Quantity)
+	if err != nil {
+		return err
+	}
+
+	return updateStockTransferStatus(tx, t, domain.TransferInTransit)
+}
+
+// ReceiveStockTransfer puts the transferred units into the destination
+// warehouse and back into the product stock.
+func (a *Adapter) ReceiveStockTransfer(ctx context.Context, id int64) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	t, err := lockStockTransfer(tx, id, domain.TransferInTransit)
+	if err != nil {
+		return err
+	}
+
+	_, err = changeStock(tx, &StockMovement{
+		ProductID:   t.ProductID,
+		WarehouseID: &t.ToWarehouseID,
+		Reason:      string(domain.MovementTransfer),
+		Quantity:    t.Quantity,
+		Reference:   transferReference(t.ID),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = changeWarehouseStock(tx, t.ProductID, t.ToWarehouseID, t.Quantity)
+	if err != nil {
+		return err
+	}
+
+	return updateStockTransferStatus(tx, t, domain.TransferReceived)
+}
+
+func (a *Adapter) CancelStockTransfer(ctx context.Context, id int64) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	t, err := lockStockTransfer(tx, id, domain.TransferRequested)
+	if err != nil {
+		return err
+	}
+
+	return updateStockTransferStatus(tx, t, domain.TransferCancelled)
+}
+
+// lockStockTransfer locks the transfer for the rest of the transaction. It
+// fails with domain.ErrEditConflict when the transfer has moved out of the
+// given status concurrently.
+func lockStockTransfer(tx *gorm.DB, id int64, status domain.TransferStatus) (*StockTransfer, error) {
+	t := &StockTransfer{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(t, id).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select stock transfer by id=%d: %w", id, err)
+		}
+	}
+
+	if t.Status != string(status) {
+		return nil, domain.ErrEditConflict
+	}
+
+	return t, nil
+}
+
+func updateStockTransferStatus(tx *gorm.DB, t *StockTransfer, status domain.TransferStatus) error {
+	err := tx.Model(t).Update("status", string(status)).Error
+	if err != nil {
+		return fmt.Errorf("update status of stock transfer id=%d: %w", t.ID, err)
+	}
+
+	return nil
+}
+
+func transferReference(id int64) string {
+	return fmt.Sprintf("transfer:%d", id)
+}
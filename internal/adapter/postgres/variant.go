@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	db := a.db.WithContext(ctx)
+
+	var found bool
+	err := db.Model(&Product{}).Select("count(*) > 0").Where("id = ?", productID).Take(&found).Error
+	if err != nil {
+		return nil, fmt.Errorf("select product by id=%d: %w", productID, err)
+	}
+	if !found {
+		return nil, domain.ErrNotFound
+	}
+
+	var variants []ProductVariant
+	err = db.Where("product_id = ?", productID).Order("id").Find(&variants).Error
+	if err != nil {
+		return nil, fmt.Errorf("select variants of product id=%d: %w", productID, err)
+	}
+
+	return domainVariants(variants), nil
+}
+
+// CreateVariant fails with domain.ErrAlreadyExists when the product has a
+// variant with the same options, or the SKU is taken. The opening stock of
+// the variant is recorded as a receipt.
+func (a *Adapter) CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (id int64, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	v := &ProductVariant{
+		ProductID:     req.ProductID,
+		SKU:           nullableString(req.SKU),
+		Options:       req.Options,
+		StockNumber:   req.StockNumber,
+		DiscountPrice: req.DiscountPrice,
+		ActualPrice:   req.ActualPrice,
+	}
+	err = tx.Omit(clause.Associations).Create(v).Error
+	if err != nil {
+		return 0, variantError(err, "insert variant")
+	}
+
+	err = recordProductChanges(tx, []int64{v.ProductID}, domain.ProductUpdated)
+	if err != nil {
+		return 0, err
+	}
+
+	err = recordVariantStock(tx, v, 0, domain.MovementReceipt)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.ID, nil
+}
+
+// UpdateVariant records a change of the stock of the variant as an
+// adjustment.
+func (a *Adapter) UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	v, err := lockVariant(tx, req.ID, req.Version)
+	if err != nil {
+		return err
+	}
+	prevStock := v.StockNumber
+
+	v.StockNumber = req.StockNumber
+	err = tx.Model(v).Updates(map[string]any{
+		"sku":            nullableString(req.SKU),
+		"options":        stringMap(req.Options),
+		"stock_number":   req.StockNumber,
+		"discount_price": req.DiscountPrice,
+		"actual_price":   req.ActualPrice,
+		"version":        req.Version + 1,
+	}).Error
+	if err != nil {
+		return variantError(err, fmt.Sprintf("update variant id=%d", req.ID))
+	}
+
+	err = recordProductChanges(tx, []int64{v.ProductID}, domain.ProductUpdated)
+	if err != nil {
+		return err
+	}
+
+	return recordVariantStock(tx, v, prevStock, domain.MovementAdjustment)
+}
+
+// DeleteVariant records the stock the variant had left as an adjustment.
+func (a *Adapter) DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) (err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	v, err := lockVariant(tx, req.ID, req.Version)
+	if err != nil {
+		return err
+	}
+	prevStock := v.StockNumber
+
+	err = tx.Delete(v).Error
+	if err != nil {
+		return fmt.Errorf("delete variant id=%d: %w", req.ID, err)
+	}
+
+	err = recordProductChanges(tx, []int64{v.ProductID}, domain.ProductUpdated)
+	if err != nil {
+		return err
+	}
+
+	v.StockNumber = 0
+	return recordVariantStock(tx, v, prevStock, domain.MovementAdjustment)
+}
+
+// lockVariant locks the variant at the given version for the rest of the
+// transaction. It fails with domain.ErrEditConflict when the variant is gone
+// or at another version.
+func lockVariant(tx *gorm.DB, id, version int64) (*ProductVariant, error) {
+	v := &ProductVariant{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND version = ?", id, version).
+		Take(v).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrEditConflict
+		default:
+			return nil, fmt.Errorf("select variant id=%d: %w", id, err)
+		}
+	}
+
+	return v, nil
+}
+
+// recordVariantStock adds the change of the stock of the variant from
+// before to its current stock to the stock ledger.
+func recordVariantStock(tx *gorm.DB, v *ProductVariant, before int, reason domain.StockMovementReason) error {
+	if v.StockNumber == before {
+		return nil
+	}
+
+	return insertStockMovement(tx, &StockMovement{
+		ProductID:  v.ProductID,
+		VariantID:  &v.ID,
+		Reason:     string(reason),
+		Quantity:   v.StockNumber - before,
+		StockAfter: v.StockNumber,
+	})
+}
+
+func variantError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return domain.ErrAlreadyExists
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return domain.ErrAssociationNotFound
+	default:
+		return fmt.Errorf("%s: %w", action, err)
+	}
+}
+
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Adapter) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (int64, error) {
+	db := a.db.WithContext(ctx)
+
+	w := &Warehouse{
+		Code: req.Code,
+		Name: req.Name,
+	}
+	err := db.Create(w).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			return 0, domain.ErrAlreadyExists
+		default:
+			return 0, fmt.Errorf("insert warehouse: %w", err)
+		}
+	}
+
+	return w.ID, nil
+}
+
+func (a *Adapter) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error) {
+	db := a.db.WithContext(ctx)
+
+	var warehouses []*Warehouse
+	err := db.Order("id").Find(&warehouses).Error
+	if err != nil {
+		return nil, fmt.Errorf("select warehouses: %w", err)
+	}
+
+	return domainWarehouses(warehouses), nil
+}
+
+func (a *Adapter) GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error) {
+	db := a.db.WithContext(ctx)
+
+	product := &Product{}
+	err := db.Select("id", "stock_number").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		First(product, productID).
+		Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrNotFound
+		default:
+			return nil, fmt.Errorf("select stock of product id=%d: %w", productID, err)
+		}
+	}
+
+	warehouses := domainWarehouseStocks(product.WarehouseStocks)
+
+	return &domain.ProductStock{
+		ProductID:            product.ID,
+		StockNumber:          product.StockNumber,
+		WarehouseStockNumber: domain.SumWarehouseStocks(warehouses),
+		Warehouses:           warehouses,
+	}, nil
+}
+
+// AdjustWarehouseStock applies a relative change to the stock a warehouse
+// holds of a product, and the same change to the stock of the product.
+func (a *Adapter) AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (stock *domain.WarehouseStock, err error) {
+	db := a.db.WithContext(ctx)
+
+	tx := db.Begin()
+	defer func() {
+		var txErr error
+		if err == nil {
+			txErr = tx.Commit().Error
+		} else {
+			txErr = tx.Rollback().Error
+		}
+
+		if txErr != nil {
+			err = fmt.Errorf("%w: %w", txErr, err)
+		}
+	}()
+
+	w := &Warehouse{}
+	err = tx.First(w, req.WarehouseID).Error
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.ErrAssociationNotFound
+		default:
+			return nil, fmt.Errorf("select warehouse by id=%d: %w", req.WarehouseID, err)
+		}
+	}
+
+	_, err = changeStock(tx, &StockMovement{
+		ProductID:   req.ProductID,
+		WarehouseID: &req.WarehouseID,
+		Reason:      string(req.Reason),
+		Quantity:    req.Delta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := changeWarehouseStock(tx, req.ProductID, req.WarehouseID, req.Delta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WarehouseStock{
+		WarehouseID:   w.ID,
+		WarehouseCode: w.Code,
+		WarehouseName: w.Name,
+		StockNumber:   n,
+	}, nil
+}
+
+// GetStockDiscrepancies returns a page of the products stocked in at least
+// one warehouse whose stock differs from the sum of their warehouse stocks.
+func (a *Adapter) GetStockDiscrepancies(ctx context.Context, filter domain.Filter) (int64, []*domain.ProductStock, error) {
+	db := a.db.WithContext(ctx)
+
+	located := db.Model(&WarehouseStock{}).
+		Select("product_id, SUM(stock_number) AS stock_number").
+		Group("product_id")
+
+	var products []*Product
+	query := db.Model(&products).
+		Joins("JOIN (?) AS located ON located.product_id = products.id", located).
+		Where("located.stock_number <> products.stock_number")
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("count stock discrepancies: %w", err)
+	}
+
+	stocks := make([]*domain.ProductStock, 0)
+	if total == 0 {
+		return 0, stocks, nil
+	}
+
+	err = query.Select("products.id", "products.stock_number").
+		Preload("WarehouseStocks", orderByWarehouse).
+		Preload("WarehouseStocks.Warehouse").
+		Order("products.id").
+		Offset(int(filter.Offset())).
+		Limit(filter.Limit()).
+		Find(&products).
+		Error
+	if err != nil {
+		return 0, nil, fmt.Errorf("select stock discrepancies: %w", err)
+	}
+
+	for _, p := range products {
+		warehouses := domainWarehouseStocks(p.WarehouseStocks)
+		stocks = append(stocks, &domain.ProductStock{
+			ProductID:            p.ID,
+			StockNumber:          p.StockNumber,
+			WarehouseStockNumber: domain.SumWarehouseStocks(warehouses),
+			Warehouses:           warehouses,
+		})
+	}
+
+	return total, stocks, nil
+}
+
+// changeWarehouseStock adds delta to the stock a warehouse holds of a product
+// and returns the new stock number, which never drops below zero.
+func changeWarehouseStock(tx *gorm.DB, productID, warehouseID int64, delta int) (int, error) {
+	var n int
+	var res *gorm.DB
+	if delta > 0 {
+		res = tx.Raw(`INSERT INTO warehouse_stocks (product_id, warehouse_id, stock_number, created_at, updated_at)
+			VALUES (@product, @warehouse, @delta, now(), now())
+			ON CONFLICT (product_id, warehouse_id)
+			DO UPDATE SET stock_number = warehouse_stocks.stock_number + @delta, updated_at = now()
+			RETURNING stock_number`,
+			map[string]any{"product": productID, "warehouse": warehouseID, "delta": delta},
+		).Scan(&n)
+	} else {
+		res = tx.Raw(`UPDATE warehouse_stocks
+			SET stock_number = stock_number + @delta, updated_at = now()
+			WHERE product_id = @product AND warehouse_id = @warehouse AND stock_number + @delta >= 0
+			RETURNING stock_number`,
+			map[string]any{"product": productID, "warehouse": warehouseID, "delta": delta},
+		).Scan(&n)
+	}
+	if err := res.Error; err != nil {
+		return 0, fmt.Errorf("update stock of product id=%d in warehouse id=%d: %w", productID, warehouseID, err)
+	}
+
+	if res.RowsAffected == 0 {
+		return 0, domain.ErrInsufficientStock
+	}
+
+	return n, nil
+}
+
+func orderByWarehouse(db *gorm.DB) *gorm.DB {
+	return db.Order("warehouse_id")
+}
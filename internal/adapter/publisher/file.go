@@ -0,0 +1,69 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	"github.com/ebisaan/inventory/internal/application/port"
+)
+
+var _ port.Publisher = (*File)(nil)
+
+// File appends the published events to a file, one JSON object per line.
+type File struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+type fileEvent struct {
+	ID         int64           `json:"id"`
+	Type       string          `json:"type"`
+	ProductID  int64           `json:"product_id"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func NewFile(path string) (*File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open event file: %w", err)
+	}
+
+	return &File{f: f}, nil
+}
+
+// Publish writes the events and syncs the file before returning.
+func (p *File) Publish(_ context.Context, events []*domain.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	enc := json.NewEncoder(p.f)
+	for _, e := range events {
+		err := enc.Encode(fileEvent{
+			ID:         e.ID,
+			Type:       string(e.Type),
+			ProductID:  e.ProductID,
+			Payload:    e.Payload,
+			OccurredAt: e.OccurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("write event id=%d: %w", e.ID, err)
+		}
+	}
+
+	err := p.f.Sync()
+	if err != nil {
+		return fmt.Errorf("sync event file: %w", err)
+	}
+
+	return nil
+}
+
+func (p *File) Close() error {
+	return p.f.Close()
+}
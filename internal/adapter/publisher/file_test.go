@@ -0,0 +1,66 @@
+package publisher_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ebisaan/inventory/internal/adapter/publisher"
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	occurredAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	events := []*domain.Event{
+		{ID: 1, Type: domain.EventProductCreated, ProductID: 7, Payload: json.RawMessage(`{"ID":7}`), OccurredAt: occurredAt},
+		{ID: 2, Type: domain.EventStockChanged, ProductID: 7, Payload: json.RawMessage(`{"StockAfter":4}`), OccurredAt: occurredAt},
+	}
+
+	// A reopened file is appended to.
+	for _, batch := range [][]*domain.Event{events[:1], events[1:]} {
+		p, err := publisher.NewFile(path)
+		require.NoError(t, err)
+		err = p.Publish(context.Background(), batch)
+		require.NoError(t, err)
+		require.NoError(t, p.Close())
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var got []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]any
+		err = json.Unmarshal(scanner.Bytes(), &line)
+		require.NoError(t, err)
+		got = append(got, line)
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []map[string]any{
+		{
+			"id":          1.0,
+			"type":        "product.created",
+			"product_id":  7.0,
+			"payload":     map[string]any{"ID": 7.0},
+			"occurred_at": "2024-03-01T09:30:00Z",
+		},
+		{
+			"id":          2.0,
+			"type":        "stock.changed",
+			"product_id":  7.0,
+			"payload":     map[string]any{"StockAfter": 4.0},
+			"occurred_at": "2024-03-01T09:30:00Z",
+		},
+	}, got)
+}
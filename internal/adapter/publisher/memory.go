@@ -0,0 +1,42 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+	"github.com/ebisaan/inventory/internal/application/port"
+)
+
+var _ port.Publisher = (*Memory)(nil)
+
+// Memory keeps every published event in memory, so it is meant for tests
+// only.
+type Memory struct {
+	mu     sync.Mutex
+	events []*domain.Event
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(_ context.Context, events []*domain.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, events...)
+
+	return nil
+}
+
+// Events returns the events published so far, in order.
+func (m *Memory) Events() []*domain.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]*domain.Event, len(m.events))
+	copy(events, m.events)
+
+	return events
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ebisaan/inventory/internal/application/core/domain"
@@ -11,43 +12,255 @@ import (
 var _ port.API = (*Application)(nil)
 
 type Application struct {
-	db port.DB
-	v  *validate
+	db         port.DB
+	v          *validate
+	publisher  port.Publisher
+	notifier   port.Notifier
+	hub        *productHub
+	costMethod domain.CostMethod
 }
 
-func NewApplication(db port.DB) (*Application, error) {
+type Option func(*Application)
+
+// WithPublisher sets the publisher RelayEvents hands the outbox events to.
+func WithPublisher(p port.Publisher) Option {
+	return func(a *Application) {
+		a.publisher = p
+	}
+}
+
+// WithNotifier sets the notifier RelayEvents hands the low stock alerts to.
+func WithNotifier(n port.Notifier) Option {
+	return func(a *Application) {
+		a.notifier = n
+	}
+}
+
+// WithCostMethod sets how received goods are costed. It defaults to
+// domain.CostFIFO.
+func WithCostMethod(m domain.CostMethod) Option {
+	return func(a *Application) {
+		a.costMethod = m
+	}
+}
+
+func NewApplication(db port.DB, opts ...Option) (*Application, error) {
 	v, err := newValidate("json")
 	if err != nil {
 		return nil, err
 	}
-	return &Application{
-		db: db,
-		v:  v,
-	}, nil
+	a := &Application{
+		db:         db,
+		v:          v,
+		hub:        newProductHub(),
+		costMethod: domain.CostFIFO,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if !a.costMethod.IsValid() {
+		return nil, fmt.Errorf("unknown cost method %q", a.costMethod)
+	}
+
+	return a, nil
 }
 
-func (a *Application) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
-	return a.db.GetProductByID(ctx, id)
+func (a *Application) GetProductByID(ctx context.Context, id int64, opts ...domain.ReadOptions) (*domain.Product, error) {
+	return a.getProduct(ctx, opts, func() (*domain.Product, error) {
+		return a.db.GetProductByID(ctx, id)
+	})
+}
+
+func (a *Application) GetProductBySKU(ctx context.Context, sku string, opts ...domain.ReadOptions) (*domain.Product, error) {
+	return a.getProduct(ctx, opts, func() (*domain.Product, error) {
+		return a.db.GetProductBySKU(ctx, sku)
+	})
+}
+
+// GetProductByBarcode accepts a UPC-A code for a product stored with the
+// equivalent EAN-13 code.
+func (a *Application) GetProductByBarcode(ctx context.Context, barcode string, opts ...domain.ReadOptions) (*domain.Product, error) {
+	return a.getProduct(ctx, opts, func() (*domain.Product, error) {
+		return a.db.GetProductByBarcode(ctx, domain.NormalizeBarcode(barcode))
+	})
+}
+
+// getProduct reads a single product with get and applies the read options.
+func (a *Application) getProduct(ctx context.Context, opts []domain.ReadOptions, get func() (*domain.Product, error)) (*domain.Product, error) {
+	var opt domain.ReadOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+		err := a.v.ValidateStruct(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	product, err := get()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.convertPrices(ctx, []*domain.Product{product}, opt.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
 
 func (a *Application) GetProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	err := a.v.ValidateStruct(filter)
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+
 	filter = domain.ProcessFilter(filter)
+
+	var products []*domain.Product
+	var metadata domain.Metadata
+	if filter.IsKeyset() {
+		products, metadata, err = a.getProductsByKeyset(ctx, filter)
+	} else {
+		products, metadata, err = a.getProductsByPage(ctx, filter)
+	}
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+
+	// Prices are converted last, as cursors carry the stored prices.
+	err = a.convertPrices(ctx, products, filter.DisplayCurrency)
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+
+	return products, metadata, nil
+}
+
+// GetProductsByIDs returns the products found in the order of ids, and the
+// ids without a product. Repeated ids are looked up once.
+func (a *Application) GetProductsByIDs(ctx context.Context, ids []int64) ([]*domain.Product, []int64, error) {
+	err := a.v.ValidateStruct(domain.GetProductsByIDsRequest{IDs: ids})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unique := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := a.db.GetProductsByIDs(ctx, unique)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get products by ids from db: %w", err)
+	}
+
+	byID := make(map[int64]*domain.Product, len(found))
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+
+	products := make([]*domain.Product, 0, len(found))
+	var missingIDs []int64
+	for _, id := range unique {
+		if p, ok := byID[id]; ok {
+			products = append(products, p)
+		} else {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return products, missingIDs, nil
+}
+
+func (a *Application) getProductsByPage(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
 	n, products, err := a.db.GetProducts(ctx, filter)
 	if err != nil {
 		return nil, domain.Metadata{}, fmt.Errorf("get products from db: %w", err)
 	}
 
 	metadata := domain.MakeMetadata(n, filter.Page, filter.PageSize)
+	if filter.SkipCount {
+		metadata = domain.Metadata{
+			CurrentPage: filter.Page,
+			FirstPage:   1,
+			PageSize:    filter.PageSize,
+		}
+	}
+
+	return products, metadata, nil
+}
+
+// getProductsByKeyset asks the db for one product more than the page size to
+// find out whether another page follows in the direction of travel.
+func (a *Application) getProductsByKeyset(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	if filter.Cursor != "" {
+		c, err := domain.DecodeCursor(filter.Cursor)
+		if err != nil || c.SortBy != filter.SortBy || c.SortDirection != filter.SortDirection {
+			return nil, domain.Metadata{}, invalidCursorError()
+		}
+		filter.Position = &c
+	}
+
+	pageSize := filter.PageSize
+	filter.PageSize++
+	n, products, err := a.db.GetProducts(ctx, filter)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, domain.Metadata{}, invalidCursorError()
+		}
+		return nil, domain.Metadata{}, fmt.Errorf("get products from db: %w", err)
+	}
+	filter.PageSize = pageSize
+
+	backward := filter.Position != nil && filter.Position.Backward
+	hasMore := len(products) > pageSize
+	if hasMore {
+		if backward {
+			products = products[1:]
+		} else {
+			products = products[:pageSize]
+		}
+	}
+
+	metadata := domain.Metadata{
+		PageSize:     pageSize,
+		TotalRecords: n,
+	}
+	if len(products) > 0 {
+		hasNext := backward || hasMore
+		hasPrev := (backward && hasMore) || (!backward && filter.Position != nil)
+		if hasNext {
+			metadata.NextCursor = domain.ProductCursor(products[len(products)-1], filter, false).Encode()
+		}
+		if hasPrev {
+			metadata.PrevCursor = domain.ProductCursor(products[0], filter, true).Encode()
+		}
+	}
 
 	return products, metadata, nil
 }
 
+func invalidCursorError() error {
+	return domain.ValidationError{
+		FieldErrorMessages: map[string]string{
+			"Cursor": "Cursor is invalid",
+		},
+	}
+}
+
 func (a *Application) CreateProduct(ctx context.Context, product *domain.CreateProductRequest) (id int64, err error) {
 	err = a.v.ValidateStruct(product)
 	if err != nil {
 		return 0, err
 	}
 
+	product.Barcode = domain.NormalizeBarcode(product.Barcode)
+
 	found, err := a.db.IsSubCategoryExists(ctx, product.SubCategory)
 	if err != nil {
 		return 0, fmt.Errorf("is subcategory exists: %w", err)
@@ -60,17 +273,9 @@ func (a *Application) CreateProduct(ctx context.Context, product *domain.CreateP
 		}
 	}
 
-	found, err = a.db.IsCurrencyCodeExists(ctx, product.CurrencyCode)
+	err = a.checkCurrencyCode(ctx, product.CurrencyCode)
 	if err != nil {
-		return 0, fmt.Errorf("is currency code exists: %w", err)
-	}
-
-	if !found {
-		return 0, domain.ValidationError{
-			FieldErrorMessages: map[string]string{
-				"Subcategory": "not exists",
-			},
-		}
+		return 0, err
 	}
 
 	id, err = a.db.CreateProduct(ctx, product)
@@ -86,6 +291,14 @@ func (a *Application) UpdateProduct(ctx context.Context, req *domain.UpdateProdu
 	if err != nil {
 		return err
 	}
+	req.Barcode = domain.NormalizeBarcode(req.Barcode)
+
+	if req.CurrencyCode != "" {
+		err = a.checkCurrencyCode(ctx, req.CurrencyCode)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = a.db.UpdateProduct(ctx, req)
 	return err
@@ -100,3 +313,20 @@ func (a *Application) DeleteProduct(ctx context.Context, req *domain.DeleteProdu
 	err = a.db.DeleteProduct(ctx, req)
 	return err
 }
+
+func (a *Application) SearchProducts(ctx context.Context, query string, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	err := a.v.ValidateStruct(domain.SearchProductsRequest{Query: query, Filter: filter})
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+
+	filter = domain.ProcessFilter(filter)
+	n, products, err := a.db.SearchProducts(ctx, query, filter)
+	if err != nil {
+		return nil, domain.Metadata{}, fmt.Errorf("search products in db: %w", err)
+	}
+
+	metadata := domain.MakeMetadata(n, filter.Page, filter.PageSize)
+
+	return products, metadata, nil
+}
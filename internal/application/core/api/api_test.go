@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ebisaan/inventory/internal/adapter/publisher"
 	api "github.com/ebisaan/inventory/internal/application/core/api"
 	"github.com/ebisaan/inventory/internal/application/core/domain"
 	"github.com/ebisaan/inventory/internal/application/port"
@@ -181,6 +183,7 @@ func TestApplication_UpdateProduct(t *testing.T) {
 		CurrencyCode:  "VND",
 		Version:       1,
 	}
+	db.EXPECT().IsCurrencyCodeExists(mock.Anything, "VND").Return(true, nil)
 	db.EXPECT().UpdateProduct(mock.Anything, req).Return(nil)
 
 	var app port.API
@@ -191,6 +194,32 @@ func TestApplication_UpdateProduct(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestApplication_UpdateProduct_DisabledCurrency(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	req := &domain.UpdateProductRequest{
+		ID:           1,
+		Name:         "Songoku",
+		ActualPrice:  50000,
+		CurrencyCode: "VND",
+		Version:      1,
+	}
+	db.EXPECT().IsCurrencyCodeExists(mock.Anything, "VND").Return(false, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	err = app.UpdateProduct(context.Background(), req)
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Contains(t, validationErr.FieldErrorMessages, "CurrencyCode")
+}
+
 func TestApplication_UpdateProduct_FailedValidation(t *testing.T) {
 	db := mock_port.NewMockDB(t)
 	req := &domain.UpdateProductRequest{
@@ -253,3 +282,996 @@ func TestApplication_DeleteProduct_FailedValidation(t *testing.T) {
 
 	assert.Len(t, validationErr.FieldErrorMessages, 2)
 }
+
+func TestApplication_ReserveStock(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	req := &domain.ReserveStockRequest{
+		ProductID: 1,
+		OrderID:   "order-1",
+		Quantity:  2,
+	}
+	want := &domain.Reservation{
+		ID:        1,
+		ProductID: 1,
+		OrderID:   "order-1",
+		Quantity:  2,
+		Status:    domain.ReservationHeld,
+	}
+	db.EXPECT().ReserveStock(mock.Anything, &domain.ReserveStockRequest{
+		ProductID: 1,
+		OrderID:   "order-1",
+		Quantity:  2,
+		TTL:       domain.DefaultReservationTTL,
+	}).Return(want, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.ReserveStock(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+}
+
+func TestApplication_ReserveStock_FailedValidation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	req := &domain.ReserveStockRequest{
+		Quantity: 0,
+		TTL:      -time.Minute,
+	}
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.ReserveStock(context.Background(), req)
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 4)
+}
+
+func TestApplication_ReleaseExpiredReservations(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().ReleaseExpiredReservations(mock.Anything, mock.Anything, 100).Return(100, nil).Once()
+	db.EXPECT().ReleaseExpiredReservations(mock.Anything, mock.Anything, 100).Return(3, nil).Once()
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	n, err := app.ReleaseExpiredReservations(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(103), n)
+}
+
+func TestApplication_AdjustStock(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	want := &domain.StockLevel{
+		ProductID:   1,
+		StockNumber: 7,
+		Version:     3,
+	}
+	db.EXPECT().AdjustStock(mock.Anything, &domain.AdjustStockRequest{
+		ProductID: 1,
+		Delta:     -3,
+		Reason:    "sale",
+	}).Return(want, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.AdjustStock(context.Background(), 1, -3, "sale")
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+}
+
+func TestApplication_AdjustStock_FailedValidation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.AdjustStock(context.Background(), 0, 0, "")
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 3)
+}
+
+func TestApplication_AdjustStock_Transfer(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.AdjustStock(context.Background(), 1, -3, domain.MovementTransfer)
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 1)
+}
+
+func TestApplication_GetStockMovements(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	want := []*domain.StockMovement{
+		{ID: 2, ProductID: 1, Reason: domain.MovementSale, Quantity: -3, StockAfter: 7},
+		{ID: 1, ProductID: 1, Reason: domain.MovementReceipt, Quantity: 10, StockAfter: 10},
+	}
+	db.EXPECT().GetStockMovements(mock.Anything, int64(1), domain.Filter{
+		Page:     1,
+		PageSize: domain.DefaultPageSize,
+	}).Return(int64(2), want, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, metadata, err := app.GetStockMovements(context.Background(), 1, domain.Filter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, metadata.LastPage)
+	assert.Equal(t, int64(2), metadata.TotalRecords)
+}
+
+func TestApplication_AdjustWarehouseStock_FailedValidation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	req := &domain.AdjustWarehouseStockRequest{
+		Reason: domain.MovementReservation,
+	}
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.AdjustWarehouseStock(context.Background(), req)
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 4)
+}
+
+func TestApplication_GetStockDiscrepancies(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	want := []*domain.ProductStock{{
+		ProductID:            1,
+		StockNumber:          6,
+		WarehouseStockNumber: 8,
+		Warehouses:           []domain.WarehouseStock{{WarehouseID: 1, WarehouseCode: "HN", StockNumber: 8}},
+	}}
+	db.EXPECT().GetStockDiscrepancies(mock.Anything, domain.Filter{
+		Page:     1,
+		PageSize: domain.DefaultPageSize,
+	}).Return(int64(1), want, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, metadata, err := app.GetStockDiscrepancies(context.Background(), domain.Filter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+	assert.Equal(t, int64(1), metadata.TotalRecords)
+}
+
+func TestApplication_DispatchStockTransfer(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetStockTransfer(mock.Anything, int64(1)).Return(&domain.StockTransfer{
+		ID:     1,
+		Status: domain.TransferRequested,
+	}, nil)
+	db.EXPECT().DispatchStockTransfer(mock.Anything, int64(1)).Return(nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	err = app.DispatchStockTransfer(context.Background(), 1)
+	require.NoError(t, err)
+}
+
+func TestApplication_StockTransfer_InvalidTransition(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetStockTransfer(mock.Anything, int64(1)).Return(&domain.StockTransfer{
+		ID:     1,
+		Status: domain.TransferInTransit,
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	err = app.CancelStockTransfer(context.Background(), 1)
+	if !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("got error %q, want %q", err, domain.ErrInvalidTransition)
+	}
+
+	err = app.DispatchStockTransfer(context.Background(), 1)
+	if !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("got error %q, want %q", err, domain.ErrInvalidTransition)
+	}
+}
+
+func TestApplication_GetProducts_FailedValidation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, _, err = app.GetProducts(context.Background(), domain.Filter{
+		CurrencyCode:  "XYZ",
+		MinPrice:      10,
+		MaxPrice:      5,
+		SortBy:        "popularity",
+		SortDirection: "up",
+	})
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 4)
+}
+
+func TestApplication_SearchProducts(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().SearchProducts(mock.Anything, "shock", domain.Filter{
+		Page:     1,
+		PageSize: domain.DefaultPageSize,
+	}).Return(int64(1), readOnlyTestProducts[1:2], nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, metadata, err := app.SearchProducts(context.Background(), "shock", domain.Filter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, readOnlyTestProducts[1:2], got)
+	assert.Equal(t, int64(1), metadata.TotalRecords)
+
+	_, _, err = app.SearchProducts(context.Background(), "", domain.Filter{})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+}
+
+func TestApplication_GetProducts_Keyset(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetProducts(mock.Anything, domain.Filter{
+		Page:      1,
+		PageSize:  2,
+		Keyset:    true,
+		SkipCount: true,
+	}).Return(int64(0), readOnlyTestProducts[:], nil)
+	db.EXPECT().GetProducts(mock.Anything, mock.MatchedBy(func(f domain.Filter) bool {
+		return f.Position != nil && f.Position.ID == 1 && !f.Position.Backward && f.PageSize == 2
+	})).Return(int64(0), readOnlyTestProducts[1:], nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, metadata, err := app.GetProducts(context.Background(), domain.Filter{
+		PageSize:  1,
+		Keyset:    true,
+		SkipCount: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, readOnlyTestProducts[:1], got)
+	assert.NotEmpty(t, metadata.NextCursor)
+	assert.Empty(t, metadata.PrevCursor)
+
+	got, metadata, err = app.GetProducts(context.Background(), domain.Filter{
+		PageSize:  1,
+		Cursor:    metadata.NextCursor,
+		SkipCount: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, readOnlyTestProducts[1:], got)
+	assert.Empty(t, metadata.NextCursor)
+	assert.NotEmpty(t, metadata.PrevCursor)
+
+	_, _, err = app.GetProducts(context.Background(), domain.Filter{
+		Cursor: "not a cursor",
+	})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+}
+
+func TestApplication_CreateSubCategory_FailedValidation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.CreateSubCategory(context.Background(), &domain.CreateSubCategoryRequest{})
+	require.Error(t, err)
+
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Len(t, validationErr.FieldErrorMessages, 2)
+}
+
+func TestApplication_DeleteSubCategory(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	req := &domain.DeleteSubCategoryRequest{ID: 1}
+	db.EXPECT().DeleteSubCategory(mock.Anything, req).Return(domain.ErrInUse)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	err = app.DeleteSubCategory(context.Background(), req)
+	if !errors.Is(err, domain.ErrInUse) {
+		t.Errorf("got error %q, want %q", err, domain.ErrInUse)
+	}
+}
+
+func TestApplication_GetProductByID_DisplayCurrency(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	product := *readOnlyTestProducts[1]
+	db.EXPECT().GetProductByID(mock.Anything, int64(2)).Return(&product, nil)
+	db.EXPECT().GetCurrency(mock.Anything, "VND").Return(&domain.Currency{Code: "VND", Symbol: "₫"}, nil)
+	db.EXPECT().GetExchangeRate(mock.Anything, "USD", "VND", mock.Anything).Return(nil, domain.ErrNotFound)
+	db.EXPECT().GetExchangeRate(mock.Anything, "VND", "USD", mock.Anything).Return(&domain.ExchangeRate{
+		BaseCurrency:  "VND",
+		QuoteCurrency: "USD",
+		Rate:          0.00004,
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.GetProductByID(context.Background(), 2, domain.ReadOptions{DisplayCurrency: "VND"})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 12500000, got.ActualPrice, 0.001)
+	assert.Equal(t, "VND", got.CurrencyCode)
+	assert.Equal(t, "₫", got.CurrencySymbol)
+	require.NotNil(t, got.ExchangeRate)
+	assert.Equal(t, "USD", got.ExchangeRate.BaseCurrency)
+}
+
+func TestApplication_GetExchangeRate_NotAvailable(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetExchangeRate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.GetExchangeRate(context.Background(), "USD", "EUR", time.Now())
+	if !errors.Is(err, domain.ErrNoExchangeRate) {
+		t.Errorf("got error %q, want %q", err, domain.ErrNoExchangeRate)
+	}
+}
+
+func TestApplication_ImportProducts(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	reqs := []*domain.CreateProductRequest{
+		{Name: "Songoku", SubCategory: "Toys & Games", ActualPrice: 500000, CurrencyCode: "VND"},
+		{Name: "", SubCategory: "Toys & Games", ActualPrice: -1, CurrencyCode: "VND"},
+		{Name: "Casio", SubCategory: "Clocks", ActualPrice: 50, CurrencyCode: "USD"},
+		{Name: "Vegeta", SubCategory: "Toys & Games", ActualPrice: 400000, CurrencyCode: "VND"},
+	}
+	db.EXPECT().FindMissingSubCategories(mock.Anything, []string{"Clocks", "Toys & Games"}).Return([]string{"Clocks"}, nil)
+	db.EXPECT().FindMissingCurrencyCodes(mock.Anything, []string{"USD", "VND"}).Return(nil, nil)
+	db.EXPECT().CreateProducts(mock.Anything, []*domain.CreateProductRequest{reqs[0], reqs[3]}).Return([]int64{7, 8}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	report, err := app.ImportProducts(context.Background(), reqs)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.Total)
+	assert.Equal(t, 2, report.Imported)
+	assert.Equal(t, map[int]int64{1: 7, 4: 8}, report.IDs)
+	require.Len(t, report.Errors, 2)
+	assert.Equal(t, 2, report.Errors[0].Row)
+	assert.Len(t, report.Errors[0].FieldErrorMessages, 2)
+	assert.Equal(t, domain.ImportRowError{
+		Row:                3,
+		FieldErrorMessages: map[string]string{"SubCategory": "not exists"},
+	}, report.Errors[1])
+}
+
+func TestApplication_ExportProducts(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	firstBatch := make([]*domain.Product, 500)
+	for i := range firstBatch {
+		firstBatch[i] = &domain.Product{ID: int64(i + 1)}
+	}
+	secondBatch := []*domain.Product{{ID: 501}}
+	db.EXPECT().GetProducts(mock.Anything, mock.MatchedBy(func(f domain.Filter) bool {
+		return f.Position == nil
+	})).Return(0, firstBatch, nil)
+	db.EXPECT().GetProducts(mock.Anything, mock.MatchedBy(func(f domain.Filter) bool {
+		return f.Position != nil && f.Position.ID == 500 && f.SkipCount && f.PageSize == 500
+	})).Return(0, secondBatch, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	var batches [][]*domain.Product
+	err = app.ExportProducts(context.Background(), domain.Filter{Page: 3}, func(products []*domain.Product) error {
+		batches = append(batches, products)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]*domain.Product{firstBatch, secondBatch}, batches)
+}
+
+func TestApplication_GetProductsByIDs(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetProductsByIDs(mock.Anything, []int64{2, 9, 1}).Return(readOnlyTestProducts[:], nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, missing, err := app.GetProductsByIDs(context.Background(), []int64{2, 9, 1, 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*domain.Product{readOnlyTestProducts[1], readOnlyTestProducts[0]}, got)
+	assert.Equal(t, []int64{9}, missing)
+
+	_, _, err = app.GetProductsByIDs(context.Background(), []int64{0})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+}
+
+func TestApplication_ImportProducts_Identifiers(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	reqs := []*domain.CreateProductRequest{
+		{SKU: "GK-1", Name: "Songoku", SubCategory: "Toys & Games", ActualPrice: 500000, CurrencyCode: "VND"},
+		{SKU: "GK-1", Name: "Songoku", SubCategory: "Toys & Games", ActualPrice: 500000, CurrencyCode: "VND"},
+		{Barcode: "036000291452", Name: "Vegeta", SubCategory: "Toys & Games", ActualPrice: 400000, CurrencyCode: "VND"},
+	}
+	db.EXPECT().FindMissingSubCategories(mock.Anything, mock.Anything).Return(nil, nil)
+	db.EXPECT().FindMissingCurrencyCodes(mock.Anything, mock.Anything).Return(nil, nil)
+	db.EXPECT().FindTakenIdentifiers(mock.Anything, []string{"GK-1"}, []string{"0036000291452"}).Return(nil, []string{"0036000291452"}, nil)
+	db.EXPECT().CreateProducts(mock.Anything, []*domain.CreateProductRequest{reqs[0]}).Return([]int64{7}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	report, err := app.ImportProducts(context.Background(), reqs)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Imported)
+	assert.Equal(t, []domain.ImportRowError{
+		{Row: 2, FieldErrorMessages: map[string]string{"SKU": "repeats an earlier row"}},
+		{Row: 3, FieldErrorMessages: map[string]string{"Barcode": "already exists"}},
+	}, report.Errors)
+}
+
+func TestApplication_CreateProduct_InvalidIdentifiers(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.CreateProduct(context.Background(), &domain.CreateProductRequest{
+		SKU:          "GK 1",
+		Barcode:      "4006381333932",
+		Name:         "Songoku",
+		SubCategory:  "Toys & Games",
+		ActualPrice:  500000,
+		CurrencyCode: "VND",
+	})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Equal(t, map[string]string{
+		"SKU":     "SKU must only contain letters, digits, '.', '_' or '-'",
+		"Barcode": "Barcode must be an EAN-8, UPC-A, EAN-13 or GTIN-14 code with a valid check digit",
+	}, validationErr.FieldMessages())
+}
+
+func TestApplication_GetProductByBarcode(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	want := readOnlyTestProducts[0]
+	db.EXPECT().GetProductByBarcode(mock.Anything, "0036000291452").Return(want, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.GetProductByBarcode(context.Background(), "036000291452")
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+}
+
+func TestApplication_CreateVariant_Invalid(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	price := 0.0
+	_, err = app.CreateVariant(context.Background(), &domain.CreateVariantRequest{
+		ProductID:   1,
+		StockNumber: -1,
+		ActualPrice: &price,
+	})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Equal(t, map[string]string{
+		"Options":     "Options is a required field",
+		"StockNumber": "StockNumber must be 0 or greater",
+		"ActualPrice": "ActualPrice must be greater than 0",
+	}, validationErr.FieldMessages())
+}
+
+func TestApplication_GetProductByID_DisplayCurrencyVariants(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	price := 20.0
+	product := *readOnlyTestProducts[1]
+	product.Variants = []domain.Variant{
+		{ID: 1, ProductID: 2, Options: map[string]string{"size": "S"}},
+		{ID: 2, ProductID: 2, Options: map[string]string{"size": "XL"}, ActualPrice: &price},
+	}
+	db.EXPECT().GetProductByID(mock.Anything, int64(2)).Return(&product, nil)
+	db.EXPECT().GetCurrency(mock.Anything, "VND").Return(&domain.Currency{Code: "VND", Symbol: "₫"}, nil)
+	db.EXPECT().GetExchangeRate(mock.Anything, "USD", "VND", mock.Anything).Return(&domain.ExchangeRate{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "VND",
+		Rate:          25000,
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.GetProductByID(context.Background(), 2, domain.ReadOptions{DisplayCurrency: "VND"})
+	require.NoError(t, err)
+
+	assert.Nil(t, got.Variants[0].ActualPrice)
+	require.NotNil(t, got.Variants[1].ActualPrice)
+	assert.InDelta(t, 500000, *got.Variants[1].ActualPrice, 0.001)
+	assert.Equal(t, 20.0, price)
+}
+
+func TestApplication_PurgeDeletedProducts(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	var before time.Time
+	db.EXPECT().PurgeDeletedProducts(mock.Anything, mock.Anything, 100).
+		Run(func(_ context.Context, b time.Time, _ int) { before = b }).
+		Return(100, nil).Once()
+	db.EXPECT().PurgeDeletedProducts(mock.Anything, mock.Anything, 100).Return(7, nil).Once()
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	n, err := app.PurgeDeletedProducts(context.Background(), 24*time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(107), n)
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), before, time.Minute)
+}
+
+func TestApplication_GetDeletedProducts_Keyset(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, _, err = app.GetDeletedProducts(context.Background(), domain.Filter{Keyset: true})
+	var validationErr domain.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+}
+
+func TestApplication_GetProductAsOf(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	at := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	want := readOnlyTestProducts[0]
+	db.EXPECT().GetProductSnapshot(mock.Anything, int64(1), at).Return(&domain.ProductSnapshot{
+		ProductID: 1,
+		Version:   3,
+		Change:    domain.ProductUpdated,
+		Product:   want,
+	}, nil)
+	db.EXPECT().GetProductSnapshot(mock.Anything, int64(2), at).Return(&domain.ProductSnapshot{
+		ProductID: 2,
+		Version:   5,
+		Change:    domain.ProductDeleted,
+		Product:   readOnlyTestProducts[1],
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	got, err := app.GetProductAsOf(context.Background(), 1, at)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = app.GetProductAsOf(context.Background(), 2, at)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("got error %q, want %q", err, domain.ErrNotFound)
+	}
+}
+
+func TestApplication_RelayEvents(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	pub := mock_port.NewMockPublisher(t)
+	events := []*domain.Event{
+		{ID: 1, Type: domain.EventProductCreated, ProductID: 7},
+		{ID: 2, Type: domain.EventStockChanged, ProductID: 7},
+	}
+	db.EXPECT().RelayEvents(mock.Anything, 100, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int, publish func([]*domain.Event) error) (int64, error) {
+			err := publish(events)
+			if err != nil {
+				return 0, err
+			}
+			return int64(len(events)), nil
+		})
+	pub.EXPECT().Publish(mock.Anything, events).Return(nil)
+
+	var app port.API
+	app, err := api.NewApplication(db, api.WithPublisher(pub))
+	require.NoError(t, err)
+
+	n, err := app.RelayEvents(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+}
+
+func TestApplication_RelayEvents_Batches(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	pub := publisher.NewMemory()
+	var events []*domain.Event
+	for i := 1; i <= 101; i++ {
+		events = append(events, &domain.Event{ID: int64(i), Type: domain.EventStockChanged, ProductID: 7})
+	}
+	batches := [][]*domain.Event{events[:100], events[100:]}
+	for _, batch := range batches {
+		batch := batch
+		db.EXPECT().RelayEvents(mock.Anything, 100, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ int, publish func([]*domain.Event) error) (int64, error) {
+				err := publish(batch)
+				if err != nil {
+					return 0, err
+				}
+				return int64(len(batch)), nil
+			}).Once()
+	}
+
+	var app port.API
+	app, err := api.NewApplication(db, api.WithPublisher(pub))
+	require.NoError(t, err)
+
+	n, err := app.RelayEvents(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), n)
+	assert.Equal(t, events, pub.Events())
+}
+
+func TestApplication_RelayEvents_NoPublisher(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.RelayEvents(context.Background())
+	if !errors.Is(err, domain.ErrNoPublisher) {
+		t.Errorf("got error %q, want %q", err, domain.ErrNoPublisher)
+	}
+}
+
+func TestApplication_PurgeOutboxEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []api.Option
+		unpublished bool
+		unnotified  bool
+	}{
+		{name: "no relay", unpublished: true, unnotified: true},
+		{name: "publisher", opts: []api.Option{api.WithPublisher(mock_port.NewMockPublisher(t))}, unnotified: true},
+		{name: "notifier", opts: []api.Option{api.WithNotifier(mock_port.NewMockNotifier(t))}, unpublished: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := mock_port.NewMockDB(t)
+			start := time.Now()
+			db.EXPECT().PurgeOutboxEvents(mock.Anything, mock.Anything, tt.unpublished, tt.unnotified, 100).
+				RunAndReturn(func(_ context.Context, before time.Time, _, _ bool, _ int) (int64, error) {
+					assert.WithinDuration(t, start.Add(-24*time.Hour), before, time.Minute)
+					return 100, nil
+				}).Once()
+			db.EXPECT().PurgeOutboxEvents(mock.Anything, mock.Anything, tt.unpublished, tt.unnotified, 100).
+				Return(3, nil).Once()
+
+			var app port.API
+			app, err := api.NewApplication(db, tt.opts...)
+			require.NoError(t, err)
+
+			n, err := app.PurgeOutboxEvents(context.Background(), 24*time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, int64(103), n)
+		})
+	}
+}
+
+// feedProductChanges makes the mocked database emit the events in turn, over
+// and over, until the feed stops.
+func feedProductChanges(db *mock_port.MockDB, events ...*domain.ProductChangeEvent) {
+	db.EXPECT().ListenProductChanges(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(*domain.ProductChangeEvent)) error {
+			for i := 0; ; i++ {
+				select {
+				case <-ctx.Done():
+					return nil
+				default:
+					fn(events[i%len(events)])
+				}
+			}
+		})
+}
+
+func TestApplication_WatchProducts(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	feedProductChanges(db,
+		&domain.ProductChangeEvent{Event: domain.Event{ID: 1, ProductID: 1}, SubCategory: "Watches"},
+		&domain.ProductChangeEvent{Event: domain.Event{ID: 2, ProductID: 2}, SubCategory: "Toys & Games"},
+		&domain.ProductChangeEvent{Event: domain.Event{ID: 3, ProductID: 3}, SubCategory: "Clocks"},
+	)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go app.RunProductChangeFeed(ctx)
+
+	errStop := errors.New("stop")
+	var got []int64
+	err = app.WatchProducts(ctx, &domain.WatchProductsRequest{
+		ProductIDs:    []int64{1},
+		SubCategories: []string{"Clocks"},
+	}, func(e *domain.ProductChangeEvent) error {
+		got = append(got, e.ProductID)
+		if len(got) == 10 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+
+	for _, id := range got {
+		assert.Contains(t, []int64{1, 3}, id)
+	}
+}
+
+func TestApplication_WatchProducts_TooSlow(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	feedProductChanges(db, &domain.ProductChangeEvent{Event: domain.Event{ID: 1, ProductID: 1}})
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go app.RunProductChangeFeed(ctx)
+
+	first := true
+	err = app.WatchProducts(ctx, &domain.WatchProductsRequest{}, func(*domain.ProductChangeEvent) error {
+		if first {
+			first = false
+			time.Sleep(100 * time.Millisecond)
+		}
+		return nil
+	})
+	if !errors.Is(err, domain.ErrWatcherTooSlow) {
+		t.Errorf("got error %q, want %q", err, domain.ErrWatcherTooSlow)
+	}
+}
+
+func TestApplication_RelayEvents_LowStock(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	notifier := mock_port.NewMockNotifier(t)
+	occurredAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	events := []*domain.Event{
+		{
+			ID:         2,
+			Type:       domain.EventStockLow,
+			ProductID:  7,
+			Payload:    []byte(`{"ProductID":7,"Name":"Songoku","StockNumber":4,"ReorderPoint":5,"ReorderQuantity":20}`),
+			OccurredAt: occurredAt,
+		},
+	}
+	db.EXPECT().NotifyEvents(mock.Anything, 100, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int, notify func([]*domain.Event) error) (int64, error) {
+			err := notify(events)
+			if err != nil {
+				return 0, err
+			}
+			return int64(len(events)), nil
+		})
+	notifier.EXPECT().NotifyLowStock(mock.Anything, &domain.LowStockAlert{
+		ProductID:       7,
+		Name:            "Songoku",
+		StockNumber:     4,
+		ReorderPoint:    5,
+		ReorderQuantity: 20,
+		RaisedAt:        occurredAt,
+	}).Return(nil).Once()
+
+	var app port.API
+	app, err := api.NewApplication(db, api.WithNotifier(notifier))
+	require.NoError(t, err)
+
+	n, err := app.RelayEvents(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
+
+func TestApplication_RelayEvents_FailingNotifier(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	pub := mock_port.NewMockPublisher(t)
+	notifier := mock_port.NewMockNotifier(t)
+	events := []*domain.Event{
+		{ID: 1, Type: domain.EventStockChanged, ProductID: 7, Payload: []byte(`{"StockAfter":4}`)},
+		{ID: 2, Type: domain.EventStockLow, ProductID: 7, Payload: []byte(`{"ProductID":7}`)},
+	}
+	deliver := func(events []*domain.Event) func(context.Context, int, func([]*domain.Event) error) (int64, error) {
+		return func(_ context.Context, _ int, fn func([]*domain.Event) error) (int64, error) {
+			err := fn(events)
+			if err != nil {
+				return 0, err
+			}
+			return int64(len(events)), nil
+		}
+	}
+	db.EXPECT().RelayEvents(mock.Anything, 100, mock.Anything).RunAndReturn(deliver(events)).Once()
+	db.EXPECT().NotifyEvents(mock.Anything, 100, mock.Anything).RunAndReturn(deliver(events[1:])).Once()
+	pub.EXPECT().Publish(mock.Anything, events).Return(nil).Once()
+	notifier.EXPECT().NotifyLowStock(mock.Anything, mock.Anything).Return(errors.New("sink down")).Once()
+
+	var app port.API
+	app, err := api.NewApplication(db, api.WithPublisher(pub), api.WithNotifier(notifier))
+	require.NoError(t, err)
+
+	// The events still count as published, so the next relay does not
+	// hand them to the publisher again.
+	n, err := app.RelayEvents(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int64(2), n)
+}
+
+func TestApplication_ReceiveGoods_Closed(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetPurchaseOrder(mock.Anything, int64(1)).Return(&domain.PurchaseOrder{
+		ID:     1,
+		Status: domain.PurchaseOrderCancelled,
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.ReceiveGoods(context.Background(), &domain.ReceiveGoodsRequest{
+		PurchaseOrderID: 1,
+		Lines:           []domain.ReceiveGoodsLine{{LineID: 1, Quantity: 1}},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+}
+
+func TestApplication_CreatePurchaseOrder_UnknownCurrency(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().IsCurrencyCodeExists(mock.Anything, "EUR").Return(false, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	_, err = app.CreatePurchaseOrder(context.Background(), &domain.CreatePurchaseOrderRequest{
+		SupplierID:   1,
+		CurrencyCode: "EUR",
+		Lines:        []domain.CreatePurchaseOrderLine{{ProductID: 1, Quantity: 1, UnitCost: 2}},
+	})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"CurrencyCode": "not exists"}, validationErr.FieldMessages())
+}
+
+func TestApplication_SetProductSupplier_Invalid(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	var app port.API
+	app, err := api.NewApplication(db)
+	require.NoError(t, err)
+
+	err = app.SetProductSupplier(context.Background(), &domain.SetProductSupplierRequest{
+		ProductID:    1,
+		SupplierID:   1,
+		UnitCost:     -1,
+		LeadTimeDays: -1,
+	})
+	var validationErr domain.ValidationError
+	ok := errors.As(err, &validationErr)
+	require.True(t, ok)
+
+	assert.Equal(t, map[string]string{
+		"UnitCost":     "UnitCost must be 0 or greater",
+		"CurrencyCode": "CurrencyCode is a required field",
+		"LeadTimeDays": "LeadTimeDays must be 0 or greater",
+	}, validationErr.FieldMessages())
+}
+
+func TestApplication_GetInventoryValuation(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+	db.EXPECT().GetValuation(mock.Anything).Return([]domain.ValuationLine{
+		{MainCategory: "appliances", SubCategory: "kitchen", CurrencyCode: "VND", Quantity: 2, UncostedQuantity: 4, Value: 200000},
+		{MainCategory: "toys", SubCategory: "dolls", CurrencyCode: "USD", Quantity: 3, Value: 15},
+		{MainCategory: "toys", SubCategory: "dolls", CurrencyCode: "VND", Quantity: 1, Value: 50000},
+	}, nil)
+
+	var app port.API
+	app, err := api.NewApplication(db, api.WithCostMethod(domain.CostWeightedAverage))
+	require.NoError(t, err)
+
+	report, err := app.GetInventoryValuation(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.CostWeightedAverage, report.Method)
+	assert.Len(t, report.Lines, 3)
+	assert.Equal(t, []domain.ValuationTotal{
+		{CurrencyCode: "USD", Quantity: 3, Value: 15},
+		{CurrencyCode: "VND", Quantity: 3, UncostedQuantity: 4, Value: 250000},
+	}, report.Totals)
+}
+
+func TestNewApplication_UnknownCostMethod(t *testing.T) {
+	db := mock_port.NewMockDB(t)
+
+	_, err := api.NewApplication(db, api.WithCostMethod("lifo"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) GetCategories(ctx context.Context) ([]*domain.MainCategory, error) {
+	return a.db.GetCategories(ctx)
+}
+
+func (a *Application) CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateMainCategory(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create main category: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.UpdateMainCategory(ctx, req)
+}
+
+// DeleteMainCategory deletes a main category that has no subcategories left.
+func (a *Application) DeleteMainCategory(ctx context.Context, id int64) error {
+	return a.db.DeleteMainCategory(ctx, id)
+}
+
+func (a *Application) CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateSubCategory(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create subcategory: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.UpdateSubCategory(ctx, req)
+}
+
+func (a *Application) DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.DeleteSubCategory(ctx, req)
+}
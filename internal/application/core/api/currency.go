@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error) {
+	return a.db.GetCurrencies(ctx, includeDisabled)
+}
+
+func (a *Application) CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateCurrency(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create currency: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) DisableCurrency(ctx context.Context, code string) error {
+	return a.db.SetCurrencyDisabled(ctx, code, true)
+}
+
+func (a *Application) EnableCurrency(ctx context.Context, code string) error {
+	return a.db.SetCurrencyDisabled(ctx, code, false)
+}
+
+func (a *Application) SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.SetExchangeRate(ctx, req)
+}
+
+// GetExchangeRate returns the rate from base to quote in effect at the given
+// time. When only the opposite direction of the pair is stored, its inverse
+// is returned.
+func (a *Application) GetExchangeRate(ctx context.Context, base, quote string, at time.Time) (*domain.ExchangeRate, error) {
+	if base == quote {
+		return &domain.ExchangeRate{
+			BaseCurrency:  base,
+			QuoteCurrency: quote,
+			Rate:          1,
+			EffectiveDate: at,
+		}, nil
+	}
+
+	rate, err := a.db.GetExchangeRate(ctx, base, quote, at)
+	if err == nil {
+		return rate, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("get exchange rate %s/%s: %w", base, quote, err)
+	}
+
+	rate, err = a.db.GetExchangeRate(ctx, quote, base, at)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s/%s", domain.ErrNoExchangeRate, base, quote)
+		}
+		return nil, fmt.Errorf("get exchange rate %s/%s: %w", quote, base, err)
+	}
+
+	inverse := rate.Invert()
+	return &inverse, nil
+}
+
+// convertPrices converts the prices of products into the display currency at
+// the current exchange rates. Rates are looked up once per currency.
+func (a *Application) convertPrices(ctx context.Context, products []*domain.Product, display string) error {
+	if display == "" || len(products) == 0 {
+		return nil
+	}
+
+	currency, err := a.db.GetCurrency(ctx, display)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ValidationError{
+				FieldErrorMessages: map[string]string{
+					"DisplayCurrency": "not exists",
+				},
+			}
+		}
+		return fmt.Errorf("get display currency: %w", err)
+	}
+
+	now := time.Now()
+	rates := make(map[string]*domain.ExchangeRate)
+	for _, p := range products {
+		if p.CurrencyCode == currency.Code {
+			continue
+		}
+
+		rate, ok := rates[p.CurrencyCode]
+		if !ok {
+			rate, err = a.GetExchangeRate(ctx, p.CurrencyCode, currency.Code, now)
+			if err != nil {
+				return err
+			}
+			rates[p.CurrencyCode] = rate
+		}
+
+		p.ActualPrice *= rate.Rate
+		p.DiscountPrice *= rate.Rate
+		for i := range p.Variants {
+			p.Variants[i].ConvertPrices(rate.Rate)
+		}
+		p.CurrencyCode = currency.Code
+		p.CurrencySymbol = currency.Symbol
+		p.ExchangeRate = rate
+	}
+
+	return nil
+}
+
+// checkCurrencyCode fails with a domain.ValidationError on CurrencyCode when
+// the currency is unknown or disabled.
+func (a *Application) checkCurrencyCode(ctx context.Context, code string) error {
+	found, err := a.db.IsCurrencyCodeExists(ctx, code)
+	if err != nil {
+		return fmt.Errorf("is currency code exists: %w", err)
+	}
+
+	if !found {
+		return domain.ValidationError{
+			FieldErrorMessages: map[string]string{
+				"CurrencyCode": "not exists",
+			},
+		}
+	}
+
+	return nil
+}
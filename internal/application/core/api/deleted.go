@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+const purgeBatchSize = 100
+
+// GetDeletedProducts lists the soft deleted products for administrators.
+// They are paged by page number only.
+func (a *Application) GetDeletedProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	err := a.v.ValidateStruct(filter)
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+	if filter.IsKeyset() {
+		return nil, domain.Metadata{}, domain.ValidationError{
+			FieldErrorMessages: map[string]string{
+				"Keyset": "Keyset pagination is not available for deleted products",
+			},
+		}
+	}
+
+	filter = domain.ProcessFilter(filter)
+	n, products, err := a.db.GetDeletedProducts(ctx, filter)
+	if err != nil {
+		return nil, domain.Metadata{}, fmt.Errorf("get deleted products from db: %w", err)
+	}
+
+	return products, domain.MakeMetadata(n, filter.Page, filter.PageSize), nil
+}
+
+func (a *Application) RestoreProduct(ctx context.Context, id int64) error {
+	return a.db.RestoreProduct(ctx, id)
+}
+
+// PurgeDeletedProducts permanently removes the products soft deleted longer
+// than retention ago and reports how many were removed.
+func (a *Application) PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error) {
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		n, err := a.db.PurgeDeletedProducts(ctx, before, purgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("purge deleted products: %w", err)
+		}
+		total += n
+
+		if n < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// exportBatchSize is the number of products read per query during an export.
+const exportBatchSize = 500
+
+// ExportProducts walks every product matching the filter in id order and
+// hands them to fn one batch at a time, so that the catalog never sits in
+// memory as a whole. Paging and sorting fields of the filter are ignored.
+// The export stops at the first error of fn.
+func (a *Application) ExportProducts(ctx context.Context, filter domain.Filter, fn func([]*domain.Product) error) error {
+	err := a.v.ValidateStruct(filter)
+	if err != nil {
+		return err
+	}
+
+	filter.Page = 1
+	filter.PageSize = exportBatchSize
+	filter.SortBy = ""
+	filter.SortDirection = ""
+	filter.Keyset = true
+	filter.Cursor = ""
+	filter.Position = nil
+	filter.SkipCount = true
+
+	for {
+		_, products, err := a.db.GetProducts(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("get products from db: %w", err)
+		}
+		if len(products) == 0 {
+			return nil
+		}
+
+		err = a.convertPrices(ctx, products, filter.DisplayCurrency)
+		if err != nil {
+			return err
+		}
+
+		err = fn(products)
+		if err != nil {
+			return err
+		}
+
+		if len(products) < exportBatchSize {
+			return nil
+		}
+
+		c := domain.ProductCursor(products[len(products)-1], filter, false)
+		filter.Position = &c
+	}
+}
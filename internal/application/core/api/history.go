@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// GetProductHistory returns the snapshots taken on every create, update,
+// delete and restore of the product, oldest first. Stock changes are recorded
+// in the stock ledger instead.
+func (a *Application) GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error) {
+	return a.db.GetProductHistory(ctx, productID)
+}
+
+// GetProductAsOf returns the product as it was at the given time. It fails
+// with domain.ErrNotFound when the product did not exist or was deleted then.
+// Its StockNumber comes from the stock ledger, while its Version is the one
+// of the last create, update or restore, as stock changes take no snapshot.
+func (a *Application) GetProductAsOf(ctx context.Context, id int64, at time.Time) (*domain.Product, error) {
+	snapshot, err := a.db.GetProductSnapshot(ctx, id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Change == domain.ProductDeleted {
+		return nil, domain.ErrNotFound
+	}
+
+	return snapshot.Product, nil
+}
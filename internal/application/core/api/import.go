@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// importChunkSize is the number of products inserted per transaction.
+const importChunkSize = 500
+
+// ImportProducts creates products in bulk. Rows failing validation, naming
+// an unknown subcategory or currency, or reusing a SKU or barcode are
+// reported and skipped, the others are inserted in chunks. An error is only
+// returned when the import cannot go on, along with the report of the
+// chunks inserted so far.
+func (a *Application) ImportProducts(ctx context.Context, reqs []*domain.CreateProductRequest) (*domain.ImportReport, error) {
+	report := &domain.ImportReport{
+		Total: len(reqs),
+		IDs:   make(map[int]int64),
+	}
+
+	rows := make([]int, 0, len(reqs))
+	subCategories := make(map[string]bool)
+	currencyCodes := make(map[string]bool)
+	skus := make(map[string]bool)
+	barcodes := make(map[string]bool)
+	for i, req := range reqs {
+		err := a.v.ValidateStruct(req)
+		if err != nil {
+			var validationErr domain.ValidationError
+			if !errors.As(err, &validationErr) {
+				return nil, err
+			}
+			report.AddError(i+1, validationErr.FieldErrorMessages)
+			continue
+		}
+		req.Barcode = domain.NormalizeBarcode(req.Barcode)
+
+		messages := make(map[string]string)
+		if req.SKU != "" && skus[req.SKU] {
+			messages["SKU"] = "repeats an earlier row"
+		}
+		if req.Barcode != "" && barcodes[req.Barcode] {
+			messages["Barcode"] = "repeats an earlier row"
+		}
+		if len(messages) > 0 {
+			report.AddError(i+1, messages)
+			continue
+		}
+
+		rows = append(rows, i)
+		subCategories[req.SubCategory] = true
+		currencyCodes[req.CurrencyCode] = true
+		if req.SKU != "" {
+			skus[req.SKU] = true
+		}
+		if req.Barcode != "" {
+			barcodes[req.Barcode] = true
+		}
+	}
+
+	missingSubCategories, err := a.db.FindMissingSubCategories(ctx, keys(subCategories))
+	if err != nil {
+		return nil, fmt.Errorf("find missing subcategories: %w", err)
+	}
+	missingCurrencyCodes, err := a.db.FindMissingCurrencyCodes(ctx, keys(currencyCodes))
+	if err != nil {
+		return nil, fmt.Errorf("find missing currency codes: %w", err)
+	}
+	var takenSKUs, takenBarcodes []string
+	if len(skus) > 0 || len(barcodes) > 0 {
+		takenSKUs, takenBarcodes, err = a.db.FindTakenIdentifiers(ctx, keys(skus), keys(barcodes))
+		if err != nil {
+			return nil, fmt.Errorf("find taken identifiers: %w", err)
+		}
+	}
+
+	valid := rows[:0]
+	for _, i := range rows {
+		messages := make(map[string]string)
+		if slices.Contains(missingSubCategories, reqs[i].SubCategory) {
+			messages["SubCategory"] = "not exists"
+		}
+		if slices.Contains(missingCurrencyCodes, reqs[i].CurrencyCode) {
+			messages["CurrencyCode"] = "not exists"
+		}
+		if reqs[i].SKU != "" && slices.Contains(takenSKUs, reqs[i].SKU) {
+			messages["SKU"] = "already exists"
+		}
+		if reqs[i].Barcode != "" && slices.Contains(takenBarcodes, reqs[i].Barcode) {
+			messages["Barcode"] = "already exists"
+		}
+
+		if len(messages) > 0 {
+			report.AddError(i+1, messages)
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	for start := 0; start < len(valid); start += importChunkSize {
+		chunk := valid[start:min(start+importChunkSize, len(valid))]
+
+		chunkReqs := make([]*domain.CreateProductRequest, len(chunk))
+		for j, i := range chunk {
+			chunkReqs[j] = reqs[i]
+		}
+
+		ids, err := a.db.CreateProducts(ctx, chunkReqs)
+		if err != nil {
+			return report, fmt.Errorf("create products of rows %d to %d: %w", chunk[0]+1, chunk[len(chunk)-1]+1, err)
+		}
+
+		for j, i := range chunk {
+			report.IDs[i+1] = ids[j]
+		}
+		report.Imported += len(chunk)
+	}
+
+	return report, nil
+}
+
+// keys returns the members of set in sorted order.
+func keys(set map[string]bool) []string {
+	ks := make([]string, 0, len(set))
+	for k := range set {
+		ks = append(ks, k)
+	}
+	slices.Sort(ks)
+
+	return ks
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// GetLowStockProducts lists the products at or below their reorder point.
+// They are paged by page number only.
+func (a *Application) GetLowStockProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	err := a.v.ValidateStruct(filter)
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+	if filter.IsKeyset() {
+		return nil, domain.Metadata{}, domain.ValidationError{
+			FieldErrorMessages: map[string]string{
+				"Keyset": "Keyset pagination is not available for low stock products",
+			},
+		}
+	}
+
+	filter = domain.ProcessFilter(filter)
+	n, products, err := a.db.GetLowStockProducts(ctx, filter)
+	if err != nil {
+		return nil, domain.Metadata{}, fmt.Errorf("get low stock products from db: %w", err)
+	}
+
+	return products, domain.MakeMetadata(n, filter.Page, filter.PageSize), nil
+}
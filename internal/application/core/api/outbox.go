@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+const relayBatchSize = 100
+
+// RelayEvents delivers the events waiting in the outbox, to the publisher
+// and, for low stock events, to the notifier, and reports how many were
+// delivered. Each of them keeps track of what it was handed, so that one
+// failing does not hold back the other. It fails with
+// domain.ErrNoPublisher when the application has neither.
+func (a *Application) RelayEvents(ctx context.Context) (int64, error) {
+	if a.publisher == nil && a.notifier == nil {
+		return 0, domain.ErrNoPublisher
+	}
+
+	var total int64
+	var errs []error
+	if a.publisher != nil {
+		publish := func(events []*domain.Event) error {
+			return a.publisher.Publish(ctx, events)
+		}
+		n, err := relay(ctx, a.db.RelayEvents, publish)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("relay events: %w", err))
+		}
+		total += n
+	}
+	if a.notifier != nil {
+		notify := func(events []*domain.Event) error {
+			return a.notifyLowStock(ctx, events)
+		}
+		n, err := relay(ctx, a.db.NotifyEvents, notify)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notify events: %w", err))
+		}
+		total += n
+	}
+
+	return total, errors.Join(errs...)
+}
+
+// relay runs the batches of fn until one comes back short.
+func relay(ctx context.Context, fn func(context.Context, int, func([]*domain.Event) error) (int64, error), deliver func([]*domain.Event) error) (int64, error) {
+	var total int64
+	for {
+		n, err := fn(ctx, relayBatchSize, deliver)
+		if err != nil {
+			return total, err
+		}
+		total += n
+
+		if n < relayBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// PurgeOutboxEvents deletes the relayed outbox events older than retention
+// and reports how many were deleted. Events are written even when the
+// application has neither a publisher nor a notifier, since they also feed
+// the product watchers. Those no sink will ever be handed are deleted once
+// older than retention as well.
+func (a *Application) PurgeOutboxEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		n, err := a.db.PurgeOutboxEvents(ctx, before, a.publisher == nil, a.notifier == nil, purgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("purge outbox events: %w", err)
+		}
+		total += n
+
+		if n < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (a *Application) notifyLowStock(ctx context.Context, events []*domain.Event) error {
+	for _, e := range events {
+		if e.Type != domain.EventStockLow {
+			continue
+		}
+
+		var alert domain.LowStockAlert
+		err := json.Unmarshal(e.Payload, &alert)
+		if err != nil {
+			return fmt.Errorf("decode low stock alert of event id=%d: %w", e.ID, err)
+		}
+		alert.RaisedAt = e.OccurredAt
+
+		err = a.notifier.NotifyLowStock(ctx, &alert)
+		if err != nil {
+			return fmt.Errorf("notify low stock of product id=%d: %w", alert.ProductID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	err = a.checkCurrencyCode(ctx, req.CurrencyCode)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreatePurchaseOrder(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create purchase order: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error) {
+	return a.db.GetPurchaseOrder(ctx, id)
+}
+
+// ReceiveGoods books the received quantities of a purchase order into stock
+// and records their cost. An order can be received in several goes until
+// every line is complete.
+func (a *Application) ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest) (*domain.PurchaseOrder, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.checkPurchaseOrderOpen(ctx, req.PurchaseOrderID, domain.PurchaseOrderReceived)
+	if err != nil {
+		return nil, err
+	}
+
+	po, err := a.db.ReceiveGoods(ctx, req, a.costMethod)
+	if err != nil {
+		return nil, fmt.Errorf("receive goods: %w", err)
+	}
+
+	return po, nil
+}
+
+func (a *Application) CancelPurchaseOrder(ctx context.Context, id int64) error {
+	err := a.checkPurchaseOrderOpen(ctx, id, domain.PurchaseOrderCancelled)
+	if err != nil {
+		return err
+	}
+
+	return a.db.CancelPurchaseOrder(ctx, id)
+}
+
+func (a *Application) checkPurchaseOrderOpen(ctx context.Context, id int64, next domain.PurchaseOrderStatus) error {
+	po, err := a.db.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get purchase order: %w", err)
+	}
+
+	if !po.Status.IsOpen() {
+		return fmt.Errorf("%w: %s to %s", domain.ErrInvalidTransition, po.Status, next)
+	}
+
+	return nil
+}
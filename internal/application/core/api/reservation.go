@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// releaseBatchSize is the number of expired reservations released per
+// transaction.
+const releaseBatchSize = 100
+
+func (a *Application) ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (*domain.Reservation, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r := *req
+	if r.TTL == 0 {
+		r.TTL = domain.DefaultReservationTTL
+	}
+
+	reservation, err := a.db.ReserveStock(ctx, &r)
+	if err != nil {
+		return nil, fmt.Errorf("reserve stock: %w", err)
+	}
+
+	return reservation, nil
+}
+
+func (a *Application) GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error) {
+	return a.db.GetReservations(ctx, orderID)
+}
+
+func (a *Application) ConfirmReservation(ctx context.Context, orderID string) error {
+	err := validateOrderID(orderID)
+	if err != nil {
+		return err
+	}
+
+	return a.db.ConfirmReservation(ctx, orderID)
+}
+
+func (a *Application) ReleaseReservation(ctx context.Context, orderID string) error {
+	err := validateOrderID(orderID)
+	if err != nil {
+		return err
+	}
+
+	return a.db.ReleaseReservation(ctx, orderID)
+}
+
+// ReleaseExpiredReservations returns the stock held by expired reservations
+// and reports how many reservations were released.
+func (a *Application) ReleaseExpiredReservations(ctx context.Context) (int64, error) {
+	now := time.Now()
+
+	var total int64
+	for {
+		n, err := a.db.ReleaseExpiredReservations(ctx, now, releaseBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("release expired reservations: %w", err)
+		}
+		total += n
+
+		if n < releaseBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func validateOrderID(orderID string) error {
+	if orderID == "" {
+		return domain.ValidationError{
+			FieldErrorMessages: map[string]string{
+				"OrderID": "OrderID is a required field",
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// AdjustStock applies a relative change to the stock of a product without
+// requiring its current version.
+func (a *Application) AdjustStock(ctx context.Context, productID int64, delta int, reason domain.StockMovementReason) (*domain.StockLevel, error) {
+	req := &domain.AdjustStockRequest{
+		ProductID: productID,
+		Delta:     delta,
+		Reason:    reason,
+	}
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := a.db.AdjustStock(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("adjust stock: %w", err)
+	}
+
+	return level, nil
+}
+
+func (a *Application) GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) ([]*domain.StockMovement, domain.Metadata, error) {
+	filter = domain.ProcessFilter(filter)
+	n, movements, err := a.db.GetStockMovements(ctx, productID, filter)
+	if err != nil {
+		return nil, domain.Metadata{}, fmt.Errorf("get stock movements from db: %w", err)
+	}
+
+	metadata := domain.MakeMetadata(n, filter.Page, filter.PageSize)
+
+	return movements, metadata, nil
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) GetSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	return a.db.GetSuppliers(ctx)
+}
+
+func (a *Application) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateSupplier(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create supplier: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error) {
+	return a.db.GetSupplier(ctx, id)
+}
+
+func (a *Application) UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.UpdateSupplier(ctx, req)
+}
+
+// DeleteSupplier deletes a supplier no purchase order refers to, along with
+// its product links.
+func (a *Application) DeleteSupplier(ctx context.Context, id int64) error {
+	return a.db.DeleteSupplier(ctx, id)
+}
+
+func (a *Application) GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error) {
+	return a.db.GetProductSuppliers(ctx, productID)
+}
+
+func (a *Application) SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	err = a.checkCurrencyCode(ctx, req.CurrencyCode)
+	if err != nil {
+		return err
+	}
+
+	return a.db.SetProductSupplier(ctx, req)
+}
+
+func (a *Application) RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.RemoveProductSupplier(ctx, req)
+}
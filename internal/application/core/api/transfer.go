@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateStockTransfer(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create stock transfer: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error) {
+	return a.db.GetStockTransfer(ctx, id)
+}
+
+func (a *Application) DispatchStockTransfer(ctx context.Context, id int64) error {
+	err := a.checkTransferTransition(ctx, id, domain.TransferInTransit)
+	if err != nil {
+		return err
+	}
+
+	return a.db.DispatchStockTransfer(ctx, id)
+}
+
+func (a *Application) ReceiveStockTransfer(ctx context.Context, id int64) error {
+	err := a.checkTransferTransition(ctx, id, domain.TransferReceived)
+	if err != nil {
+		return err
+	}
+
+	return a.db.ReceiveStockTransfer(ctx, id)
+}
+
+func (a *Application) CancelStockTransfer(ctx context.Context, id int64) error {
+	err := a.checkTransferTransition(ctx, id, domain.TransferCancelled)
+	if err != nil {
+		return err
+	}
+
+	return a.db.CancelStockTransfer(ctx, id)
+}
+
+func (a *Application) checkTransferTransition(ctx context.Context, id int64, next domain.TransferStatus) error {
+	t, err := a.db.GetStockTransfer(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get stock transfer: %w", err)
+	}
+
+	if !t.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s to %s", domain.ErrInvalidTransition, t.Status, next)
+	}
+
+	return nil
+}
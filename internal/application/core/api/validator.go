@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/go-playground/locales/en"
@@ -39,6 +40,11 @@ func newValidate(tagName string) (*validate, error) {
 		return nil, fmt.Errorf("register default translation(english): %w", err)
 	}
 
+	err = registerCustomValidations(v, trans)
+	if err != nil {
+		return nil, err
+	}
+
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		name := strings.SplitN(fld.Tag.Get(tagName), ",", 2)[0]
 		if name == "-" {
@@ -53,6 +59,56 @@ func newValidate(tagName string) (*validate, error) {
 	}, nil
 }
 
+var skuPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// registerCustomValidations adds the tags of the domain that the validator
+// does not know, with their english messages.
+func registerCustomValidations(v *pg_validator.Validate, trans ut.Translator) error {
+	custom := []struct {
+		tag     string
+		fn      pg_validator.Func
+		message string
+	}{
+		{
+			tag: "sku",
+			fn: func(fl pg_validator.FieldLevel) bool {
+				return skuPattern.MatchString(fl.Field().String())
+			},
+			message: "{0} must only contain letters, digits, '.', '_' or '-'",
+		},
+		{
+			tag: "barcode",
+			fn: func(fl pg_validator.FieldLevel) bool {
+				return domain.IsValidBarcode(fl.Field().String())
+			},
+			message: "{0} must be an EAN-8, UPC-A, EAN-13 or GTIN-14 code with a valid check digit",
+		},
+	}
+
+	for _, c := range custom {
+		err := v.RegisterValidation(c.tag, c.fn)
+		if err != nil {
+			return fmt.Errorf("register %s validation: %w", c.tag, err)
+		}
+
+		tag, message := c.tag, c.message
+		err = v.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, message, true)
+			},
+			func(ut ut.Translator, fe pg_validator.FieldError) string {
+				t, _ := ut.T(tag, fe.Field())
+				return t
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("register %s translation: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
 func (v *validate) ValidateStruct(s any) error {
 	err := v.validate.Struct(s)
 	if err != nil {
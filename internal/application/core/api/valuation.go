@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error) {
+	return a.db.GetCostLayers(ctx, productID)
+}
+
+// GetInventoryValuation values the stock on hand at cost, by subcategory and
+// currency, with a total per currency. Amounts in different currencies are
+// not converted.
+func (a *Application) GetInventoryValuation(ctx context.Context) (*domain.ValuationReport, error) {
+	lines, err := a.db.GetValuation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get valuation from db: %w", err)
+	}
+
+	report := &domain.ValuationReport{
+		Method: a.costMethod,
+		Lines:  lines,
+	}
+	totals := make(map[string]int)
+	for _, l := range lines {
+		i, ok := totals[l.CurrencyCode]
+		if !ok {
+			i = len(report.Totals)
+			totals[l.CurrencyCode] = i
+			report.Totals = append(report.Totals, domain.ValuationTotal{CurrencyCode: l.CurrencyCode})
+		}
+		report.Totals[i].Quantity += l.Quantity
+		report.Totals[i].UncostedQuantity += l.UncostedQuantity
+		report.Totals[i].Value += l.Value
+	}
+	slices.SortFunc(report.Totals, func(a, b domain.ValuationTotal) int {
+		return strings.Compare(a.CurrencyCode, b.CurrencyCode)
+	})
+
+	return report, nil
+}
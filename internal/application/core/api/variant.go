@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	return a.db.GetVariants(ctx, productID)
+}
+
+func (a *Application) CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateVariant(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create variant: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.UpdateVariant(ctx, req)
+}
+
+func (a *Application) DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	return a.db.DeleteVariant(ctx, req)
+}
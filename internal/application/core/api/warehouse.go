@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+func (a *Application) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (int64, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := a.db.CreateWarehouse(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("create warehouse: %w", err)
+	}
+
+	return id, nil
+}
+
+func (a *Application) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error) {
+	return a.db.GetWarehouses(ctx)
+}
+
+func (a *Application) GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error) {
+	return a.db.GetProductStock(ctx, productID)
+}
+
+func (a *Application) AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error) {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := a.db.AdjustWarehouseStock(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("adjust warehouse stock: %w", err)
+	}
+
+	return stock, nil
+}
+
+// GetStockDiscrepancies lists the products stocked in a warehouse whose stock
+// differs from the units held across their warehouses. They are paged by
+// page number only.
+func (a *Application) GetStockDiscrepancies(ctx context.Context, filter domain.Filter) ([]*domain.ProductStock, domain.Metadata, error) {
+	err := a.v.ValidateStruct(filter)
+	if err != nil {
+		return nil, domain.Metadata{}, err
+	}
+	if filter.IsKeyset() {
+		return nil, domain.Metadata{}, domain.ValidationError{
+			FieldErrorMessages: map[string]string{
+				"Keyset": "Keyset pagination is not available for stock discrepancies",
+			},
+		}
+	}
+
+	filter = domain.ProcessFilter(filter)
+	n, stocks, err := a.db.GetStockDiscrepancies(ctx, filter)
+	if err != nil {
+		return nil, domain.Metadata{}, fmt.Errorf("get stock discrepancies from db: %w", err)
+	}
+
+	return stocks, domain.MakeMetadata(n, filter.Page, filter.PageSize), nil
+}
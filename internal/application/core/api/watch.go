@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+// watchBufferSize is the number of changes a watcher may lag behind before
+// it is dropped.
+const watchBufferSize = 256
+
+type watcher struct {
+	req    *domain.WatchProductsRequest
+	events chan *domain.ProductChangeEvent
+}
+
+// productHub fans the product changes out to the watchers in process. It
+// never blocks on a slow watcher: one whose buffer is full is dropped, so
+// that it cannot hold back the others.
+type productHub struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+func newProductHub() *productHub {
+	return &productHub{watchers: make(map[*watcher]struct{})}
+}
+
+func (h *productHub) add(req *domain.WatchProductsRequest) *watcher {
+	w := &watcher{
+		req:    req,
+		events: make(chan *domain.ProductChangeEvent, watchBufferSize),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.watchers[w] = struct{}{}
+
+	return w
+}
+
+// remove closes the channel of the watcher unless it was already dropped.
+func (h *productHub) remove(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.events)
+	}
+}
+
+func (h *productHub) broadcast(e *domain.ProductChangeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watchers {
+		if !w.req.Matches(e) {
+			continue
+		}
+
+		select {
+		case w.events <- e:
+		default:
+			delete(h.watchers, w)
+			close(w.events)
+		}
+	}
+}
+
+// WatchProducts calls fn with every change of the watched products until
+// ctx is done or fn fails. It fails with domain.ErrWatcherTooSlow when fn
+// falls too far behind the changes, after which the caller may watch again.
+func (a *Application) WatchProducts(ctx context.Context, req *domain.WatchProductsRequest, fn func(*domain.ProductChangeEvent) error) error {
+	err := a.v.ValidateStruct(req)
+	if err != nil {
+		return err
+	}
+
+	w := a.hub.add(req)
+	defer a.hub.remove(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-w.events:
+			if !ok {
+				return domain.ErrWatcherTooSlow
+			}
+
+			err := fn(e)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunProductChangeFeed feeds the watchers with the changes committed to the
+// database until ctx is done or the database connection fails.
+func (a *Application) RunProductChangeFeed(ctx context.Context) error {
+	return a.db.ListenProductChanges(ctx, a.hub.broadcast)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// LowStockAlert is raised when a stock change takes a product from above
+// its reorder point to at or below it.
+type LowStockAlert struct {
+	ProductID       int64
+	SKU             string
+	Name            string
+	StockNumber     int
+	ReorderPoint    int
+	ReorderQuantity int
+	RaisedAt        time.Time
+}
+
+// CrossesReorderPoint reports whether the stock number going from before to
+// after drops to or below the reorder point from above it.
+func CrossesReorderPoint(reorderPoint *int, before, after int) bool {
+	if reorderPoint == nil {
+		return false
+	}
+
+	return before > *reorderPoint && after <= *reorderPoint
+}
@@ -0,0 +1,38 @@
+package domain
+
+// IsValidBarcode reports whether s is an EAN-8, UPC-A, EAN-13 or GTIN-14
+// code with a correct check digit.
+func IsValidBarcode(s string) bool {
+	switch len(s) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		// Weights alternate 3 and 1 from the digit left of the check digit.
+		d := int(c - '0')
+		if (len(s)-1-i)%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// NormalizeBarcode writes a UPC-A code as the equivalent EAN-13 code, which
+// is how most scanners report it. Other codes are returned as is.
+func NormalizeBarcode(s string) string {
+	if len(s) == 12 {
+		return "0" + s
+	}
+
+	return s
+}
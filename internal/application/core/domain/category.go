@@ -1,8 +1,9 @@
 package domain
 
 type MainCategory struct {
-	ID   int64
-	Name string
+	ID            int64
+	Name          string
+	SubCategories []*SubCategory
 }
 
 type SubCategory struct {
@@ -10,3 +11,33 @@ type SubCategory struct {
 	MainCategory string
 	Name         string
 }
+
+type CreateMainCategoryRequest struct {
+	Name string `validate:"required,max=255"`
+}
+
+type CreateSubCategoryRequest struct {
+	MainCategory string `validate:"required"`
+	Name         string `validate:"required,max=255"`
+}
+
+type UpdateMainCategoryRequest struct {
+	ID   int64  `validate:"required"`
+	Name string `validate:"required,max=255"`
+}
+
+// UpdateSubCategoryRequest renames a subcategory and/or moves it under
+// another main category. Empty fields are left unchanged.
+type UpdateSubCategoryRequest struct {
+	ID           int64  `validate:"required"`
+	MainCategory string `validate:"omitempty"`
+	Name         string `validate:"omitempty,max=255"`
+}
+
+// DeleteSubCategoryRequest deletes a subcategory. Products still in the
+// subcategory are moved to ReassignTo first; without it the deletion fails
+// with ErrInUse.
+type DeleteSubCategoryRequest struct {
+	ID         int64  `validate:"required"`
+	ReassignTo string `validate:"omitempty"`
+}
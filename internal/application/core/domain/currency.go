@@ -1,7 +1,49 @@
 package domain
 
+import "time"
+
 type Currency struct {
 	ID     int64
 	Code   string
 	Symbol string
+	// Disabled currencies stay on existing products and in conversions but
+	// are no longer accepted for new products.
+	Disabled bool
+}
+
+type CreateCurrencyRequest struct {
+	Code   string `validate:"required,iso4217"`
+	Symbol string `validate:"required,max=8"`
+}
+
+// ExchangeRate is the price of one unit of BaseCurrency in QuoteCurrency,
+// effective from EffectiveDate until a later rate of the pair takes over.
+type ExchangeRate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+	EffectiveDate time.Time
+}
+
+// Invert returns the rate of the opposite direction of the pair.
+func (r ExchangeRate) Invert() ExchangeRate {
+	return ExchangeRate{
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          1 / r.Rate,
+		EffectiveDate: r.EffectiveDate,
+	}
+}
+
+type SetExchangeRateRequest struct {
+	BaseCurrency  string    `validate:"required,iso4217"`
+	QuoteCurrency string    `validate:"required,iso4217,nefield=BaseCurrency"`
+	Rate          float64   `validate:"gt=0"`
+	EffectiveDate time.Time `validate:"required"`
+}
+
+// ReadOptions changes how products are read. DisplayCurrency converts the
+// prices into the given currency at the latest exchange rate.
+type ReadOptions struct {
+	DisplayCurrency string `validate:"omitempty,iso4217"`
 }
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of a keyset page: the sort key and ID of the
+// product the page starts after, or before when Backward is set.
+type Cursor struct {
+	SortBy        string `json:"s,omitempty"`
+	SortDirection string `json:"d,omitempty"`
+	Value         string `json:"v,omitempty"`
+	ID            int64  `json:"id"`
+	Backward      bool   `json:"b,omitempty"`
+}
+
+// Encode returns the opaque page token of c.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(token string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var c Cursor
+	err = json.Unmarshal(b, &c)
+	if err != nil || c.ID == 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+// ProductCursor returns the cursor positioned at p for the order of filter.
+func ProductCursor(p *Product, filter Filter, backward bool) Cursor {
+	c := Cursor{
+		SortBy:        filter.SortBy,
+		SortDirection: filter.SortDirection,
+		ID:            p.ID,
+		Backward:      backward,
+	}
+
+	switch filter.SortBy {
+	case SortByName:
+		c.Value = p.Name
+	case SortByPrice:
+		c.Value = strconv.FormatFloat(p.ActualPrice, 'g', -1, 64)
+	case SortByStock:
+		c.Value = strconv.Itoa(p.StockNumber)
+	case SortByCreatedAt:
+		c.Value = p.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return c
+}
@@ -8,4 +8,13 @@ var (
 	ErrNotFound            = errors.New("resource not found")
 	ErrAssociationNotFound = errors.New("association resource not found")
 	ErrEditConflict        = errors.New("edit conflicted")
+	ErrAlreadyExists       = errors.New("resource already exists")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrReservationExpired  = errors.New("reservation expired")
+	ErrInvalidTransition   = errors.New("invalid status transition")
+	ErrInUse               = errors.New("resource in use")
+	ErrNoExchangeRate      = errors.New("exchange rate not available")
+	ErrNoPublisher         = errors.New("no event publisher")
+	ErrWatcherTooSlow      = errors.New("watcher fell behind the product changes")
+	ErrOverReceipt         = errors.New("received quantity exceeds the quantity ordered")
 )
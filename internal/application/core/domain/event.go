@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventProductCreated  EventType = "product.created"
+	EventProductUpdated  EventType = "product.updated"
+	EventProductDeleted  EventType = "product.deleted"
+	EventProductRestored EventType = "product.restored"
+	// EventStockChanged carries the StockMovement recorded in the ledger.
+	EventStockChanged EventType = "stock.changed"
+	// EventStockLow carries the LowStockAlert of a product.
+	EventStockLow EventType = "stock.low"
+)
+
+// ProductEventType returns the type of the event published for a change of
+// a product, whose payload is the product right after the change.
+func ProductEventType(change ProductChange) EventType {
+	return EventType("product." + string(change))
+}
+
+// Event is a change published to downstream services. Events of a product
+// are published in the order they occurred, at least once.
+type Event struct {
+	ID         int64
+	Type       EventType
+	ProductID  int64
+	Payload    json.RawMessage
+	OccurredAt time.Time
+}
+
+// ProductChangeEvent is an Event delivered to watchers, together with the
+// categories of its product.
+type ProductChangeEvent struct {
+	Event
+	MainCategory string
+	SubCategory  string
+}
+
+// WatchProductsRequest selects the products whose changes are watched. An
+// event matches when its product is listed or belongs to one of the
+// categories. An empty request watches every product.
+type WatchProductsRequest struct {
+	ProductIDs     []int64  `validate:"max=100,dive,gt=0"`
+	MainCategories []string `validate:"max=20,dive,required"`
+	SubCategories  []string `validate:"max=20,dive,required"`
+}
+
+// Matches reports whether the request watches the product of e.
+func (r *WatchProductsRequest) Matches(e *ProductChangeEvent) bool {
+	if len(r.ProductIDs) == 0 && len(r.MainCategories) == 0 && len(r.SubCategories) == 0 {
+		return true
+	}
+
+	return slices.Contains(r.ProductIDs, e.ProductID) ||
+		slices.Contains(r.MainCategories, e.MainCategory) ||
+		slices.Contains(r.SubCategories, e.SubCategory)
+}
@@ -5,9 +5,47 @@ const (
 	MaxPageSize     = 100
 )
 
+const (
+	SortByName      = "name"
+	SortByPrice     = "price"
+	SortByStock     = "stock"
+	SortByCreatedAt = "created_at"
+
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
 type Filter struct {
 	Page     int
 	PageSize int
+
+	// The fields below only apply to product listings. Empty or zero values
+	// disable the corresponding condition.
+	MainCategory string
+	SubCategory  string
+	CurrencyCode string  `validate:"omitempty,iso4217"`
+	MinPrice     float64 `validate:"gte=0"`
+	MaxPrice     float64 `validate:"omitempty,gtefield=MinPrice"`
+	InStockOnly  bool
+	Name         string `validate:"max=255"`
+
+	SortBy        string `validate:"omitempty,oneof=name price stock created_at"`
+	SortDirection string `validate:"omitempty,oneof=asc desc"`
+
+	// DisplayCurrency converts the prices of the listed products, see
+	// ReadOptions.
+	DisplayCurrency string `validate:"omitempty,iso4217"`
+
+	// Keyset switches product listings from page numbers to cursors, starting
+	// at the first page. Cursor is the NextCursor or PrevCursor token of a
+	// previous page and implies Keyset.
+	Keyset bool
+	Cursor string
+	// SkipCount leaves the total number of records out of the metadata.
+	SkipCount bool
+
+	// Position is the decoded Cursor, filled in by the application.
+	Position *Cursor `validate:"-"`
 }
 
 func ProcessFilter(filter Filter) Filter {
@@ -23,9 +61,18 @@ func ProcessFilter(filter Filter) Filter {
 		filter.Page = 1
 	}
 
+	if filter.SortBy != "" && filter.SortDirection == "" {
+		filter.SortDirection = SortAsc
+	}
+
 	return filter
 }
 
+// IsKeyset reports whether the filter asks for keyset pagination.
+func (f Filter) IsKeyset() bool {
+	return f.Keyset || f.Cursor != ""
+}
+
 func (f Filter) Limit() int {
 	return f.PageSize
 }
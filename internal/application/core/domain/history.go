@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SystemActor is recorded for changes made without an actor in the context,
+// such as those of background jobs and command line tools.
+const SystemActor = "system"
+
+type ProductChange string
+
+const (
+	ProductCreated  ProductChange = "created"
+	ProductUpdated  ProductChange = "updated"
+	ProductDeleted  ProductChange = "deleted"
+	ProductRestored ProductChange = "restored"
+)
+
+// ProductSnapshot is the full state of a product right after a change.
+type ProductSnapshot struct {
+	ProductID int64
+	Version   int64
+	Change    ProductChange
+	Actor     string
+	ChangedAt time.Time
+	Product   *Product
+}
+
+type actorKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the user or service on
+// whose behalf changes are made.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or SystemActor.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	if !ok || actor == "" {
+		return SystemActor
+	}
+
+	return actor
+}
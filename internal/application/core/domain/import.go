@@ -0,0 +1,24 @@
+package domain
+
+// ImportReport sums up a bulk product import. Rows are numbered from 1 in
+// the order they were submitted.
+type ImportReport struct {
+	Total    int
+	Imported int
+	// IDs holds the id of every imported row, by row number.
+	IDs    map[int]int64
+	Errors []ImportRowError
+}
+
+// ImportRowError explains why a row was not imported.
+type ImportRowError struct {
+	Row                int
+	FieldErrorMessages map[string]string
+}
+
+func (r *ImportReport) AddError(row int, fieldErrorMessages map[string]string) {
+	r.Errors = append(r.Errors, ImportRowError{
+		Row:                row,
+		FieldErrorMessages: fieldErrorMessages,
+	})
+}
@@ -10,6 +10,11 @@ type Metadata struct {
 	LastPage     int
 	PageSize     int
 	TotalRecords int64
+
+	// NextCursor and PrevCursor are set in keyset mode when there is a page
+	// after, respectively before, the current one.
+	NextCursor string
+	PrevCursor string
 }
 
 func MakeMetadata(total int64, page, pageSize int) Metadata {
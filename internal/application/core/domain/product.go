@@ -1,7 +1,11 @@
 package domain
 
+import "time"
+
 type Product struct {
 	ID             int64
+	SKU            string
+	Barcode        string
 	Name           string `validate:"required"`
 	MainCategory   string
 	SubCategory    string  `validate:"required"`
@@ -12,31 +16,80 @@ type Product struct {
 	CurrencyCode   string  `validate:"required,iso4217"`
 	CurrencySymbol string
 	Version        int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// ReorderPoint is the stock number at or below which the product is low
+	// on stock, and ReorderQuantity the number of units to order then. A
+	// nil ReorderPoint disables the alerts.
+	ReorderPoint    *int
+	ReorderQuantity int
+
+	// Warehouses breaks the stock down by location and WarehouseStockNumber
+	// is the sum of the units held across them. Only warehouse adjustments,
+	// transfers and receipts into a warehouse change it, while every stock
+	// change moves StockNumber, so the two can differ. The products where
+	// they do are listed by GetStockDiscrepancies.
+	Warehouses           []WarehouseStock
+	WarehouseStockNumber int
+
+	// Variants lists the versions of the product on sale and
+	// VariantStockNumber is the sum of their stock.
+	Variants           []Variant
+	VariantStockNumber int
+
+	// DeletedAt is set on soft deleted products, which only the deleted
+	// products listing returns.
+	DeletedAt *time.Time
+
+	// ExchangeRate is set when the prices were converted into a display
+	// currency. Its BaseCurrency is the currency the product is priced in.
+	ExchangeRate *ExchangeRate
 }
 
 type CreateProductRequest struct {
-	Name          string  `validate:"required"`
-	SubCategory   string  `validate:"required"`
-	StockNumber   int     `validate:"gte=0"`
-	Image         string  `validate:"omitempty,uri"`
-	DiscountPrice float64 `validate:"gte=0"`
-	ActualPrice   float64 `validate:"required,gt=0"`
-	CurrencyCode  string  `validate:"required,iso4217"`
+	SKU             string  `validate:"omitempty,max=64,sku"`
+	Barcode         string  `validate:"omitempty,barcode"`
+	Name            string  `validate:"required"`
+	SubCategory     string  `validate:"required"`
+	StockNumber     int     `validate:"gte=0"`
+	Image           string  `validate:"omitempty,uri"`
+	DiscountPrice   float64 `validate:"gte=0"`
+	ActualPrice     float64 `validate:"required,gt=0"`
+	CurrencyCode    string  `validate:"required,iso4217"`
+	ReorderPoint    *int    `validate:"omitempty,gte=0"`
+	ReorderQuantity int     `validate:"gte=0"`
 }
 
+// UpdateProductRequest leaves the fields with a zero value unchanged, except
+// for ReorderPoint and ReorderQuantity which are always written: a nil
+// ReorderPoint disables the alerts.
 type UpdateProductRequest struct {
-	ID            int64   `validate:"required"`
-	Name          string  `validate:"omitempty"`
-	SubCategory   string  `validate:"omitempty"`
-	StockNumber   int     `validate:"gte=0"`
-	Image         string  `validate:"omitempty,uri"`
-	DiscountPrice float64 `validate:"gte=0"`
-	ActualPrice   float64 `validate:"required,gt=0"`
-	CurrencyCode  string  `validate:"omitempty,iso4217"`
-	Version       int64   `validate:"gte=1"`
+	ID              int64   `validate:"required"`
+	SKU             string  `validate:"omitempty,max=64,sku"`
+	Barcode         string  `validate:"omitempty,barcode"`
+	Name            string  `validate:"omitempty"`
+	SubCategory     string  `validate:"omitempty"`
+	StockNumber     int     `validate:"gte=0"`
+	Image           string  `validate:"omitempty,uri"`
+	DiscountPrice   float64 `validate:"gte=0"`
+	ActualPrice     float64 `validate:"required,gt=0"`
+	CurrencyCode    string  `validate:"omitempty,iso4217"`
+	ReorderPoint    *int    `validate:"omitempty,gte=0"`
+	ReorderQuantity int     `validate:"gte=0"`
+	Version         int64   `validate:"gte=1"`
 }
 
 type DeleteProductRequest struct {
 	ID      int64 `validate:"required"`
 	Version int64 `validate:"gte=1"`
 }
+
+type GetProductsByIDsRequest struct {
+	IDs []int64 `validate:"required,max=100,dive,gt=0"`
+}
+
+type SearchProductsRequest struct {
+	Query  string `validate:"required,max=255"`
+	Filter Filter
+}
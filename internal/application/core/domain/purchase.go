@@ -0,0 +1,72 @@
+package domain
+
+import "time"
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderOpen              PurchaseOrderStatus = "open"
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+// IsOpen reports whether an order in status s is still waiting for goods,
+// so that goods may be received against it or it may be cancelled.
+// Cancelling a partially received order gives up on the rest of it, while
+// the goods already received stay in stock.
+func (s PurchaseOrderStatus) IsOpen() bool {
+	return s == PurchaseOrderOpen || s == PurchaseOrderPartiallyReceived
+}
+
+type PurchaseOrder struct {
+	ID           int64
+	SupplierID   int64
+	CurrencyCode string
+	Status       PurchaseOrderStatus
+	Reference    string
+	ExpectedAt   *time.Time
+	Lines        []PurchaseOrderLine
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type PurchaseOrderLine struct {
+	ID               int64
+	ProductID        int64
+	Quantity         int
+	ReceivedQuantity int
+	UnitCost         float64
+}
+
+// Remaining returns the number of units of the line still to be received.
+func (l PurchaseOrderLine) Remaining() int {
+	return l.Quantity - l.ReceivedQuantity
+}
+
+type CreatePurchaseOrderRequest struct {
+	SupplierID   int64                     `validate:"required"`
+	CurrencyCode string                    `validate:"required,iso4217"`
+	Reference    string                    `validate:"max=64"`
+	ExpectedAt   *time.Time                `validate:"omitempty"`
+	Lines        []CreatePurchaseOrderLine `validate:"required,min=1,max=200,unique=ProductID,dive"`
+}
+
+type CreatePurchaseOrderLine struct {
+	ProductID int64   `validate:"required"`
+	Quantity  int     `validate:"gt=0"`
+	UnitCost  float64 `validate:"gte=0"`
+}
+
+// ReceiveGoodsRequest books the goods delivered against a purchase order.
+// They go into the warehouse when one is given.
+type ReceiveGoodsRequest struct {
+	PurchaseOrderID int64              `validate:"required"`
+	WarehouseID     int64              `validate:"omitempty,gt=0"`
+	Lines           []ReceiveGoodsLine `validate:"required,min=1,unique=LineID,dive"`
+}
+
+type ReceiveGoodsLine struct {
+	LineID   int64 `validate:"required"`
+	Quantity int   `validate:"gt=0"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+const DefaultReservationTTL = 15 * time.Minute
+
+type ReservationStatus string
+
+const (
+	ReservationHeld      ReservationStatus = "held"
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationReleased  ReservationStatus = "released"
+	ReservationExpired   ReservationStatus = "expired"
+)
+
+type Reservation struct {
+	ID        int64
+	ProductID int64
+	OrderID   string
+	Quantity  int
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type ReserveStockRequest struct {
+	ProductID int64         `validate:"required"`
+	OrderID   string        `validate:"required,max=64"`
+	Quantity  int           `validate:"gt=0"`
+	TTL       time.Duration `validate:"gte=0,lte=24h"`
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+type StockMovementReason string
+
+const (
+	MovementReceipt    StockMovementReason = "receipt"
+	MovementSale       StockMovementReason = "sale"
+	MovementReturn     StockMovementReason = "return"
+	MovementAdjustment StockMovementReason = "adjustment"
+	MovementDamage     StockMovementReason = "damage"
+	// MovementTransfer is recorded by stock transfers between warehouses
+	// and cannot be used with AdjustStock.
+	MovementTransfer StockMovementReason = "transfer"
+	// MovementReservation and MovementRelease are recorded by stock
+	// reservations and cannot be used with AdjustStock.
+	MovementReservation StockMovementReason = "reservation"
+	MovementRelease     StockMovementReason = "release"
+)
+
+type StockLevel struct {
+	ProductID   int64
+	StockNumber int
+	Version     int64
+}
+
+type AdjustStockRequest struct {
+	ProductID int64               `validate:"required"`
+	Delta     int                 `validate:"required"`
+	Reason    StockMovementReason `validate:"required,oneof=receipt sale return adjustment damage"`
+}
+
+// StockMovement is an entry of the append-only ledger of stock changes. A
+// movement with a VariantID changes the stock of that variant, and its
+// StockAfter is the stock of the variant.
+type StockMovement struct {
+	ID          int64
+	ProductID   int64
+	WarehouseID int64
+	VariantID   int64
+	Reason      StockMovementReason
+	Quantity    int
+	StockAfter  int
+	Reference   string
+	CreatedAt   time.Time
+}
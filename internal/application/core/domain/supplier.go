@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+type Supplier struct {
+	ID        int64
+	Name      string
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateSupplierRequest struct {
+	Name  string `validate:"required,max=255"`
+	Email string `validate:"omitempty,email,max=255"`
+}
+
+type UpdateSupplierRequest struct {
+	ID    int64  `validate:"required"`
+	Name  string `validate:"required,max=255"`
+	Email string `validate:"omitempty,email,max=255"`
+}
+
+// ProductSupplier is a supplier the product can be bought from, and on
+// which terms.
+type ProductSupplier struct {
+	ProductID    int64
+	SupplierID   int64
+	SupplierName string
+	SupplierSKU  string
+	UnitCost     float64
+	CurrencyCode string
+	LeadTimeDays int
+	Preferred    bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// SetProductSupplierRequest links a supplier to a product, or replaces the
+// terms of an existing link. A product has at most one preferred supplier,
+// so marking a link as preferred unmarks the others.
+type SetProductSupplierRequest struct {
+	ProductID    int64   `validate:"required"`
+	SupplierID   int64   `validate:"required"`
+	SupplierSKU  string  `validate:"omitempty,max=64"`
+	UnitCost     float64 `validate:"gte=0"`
+	CurrencyCode string  `validate:"required,iso4217"`
+	LeadTimeDays int     `validate:"gte=0,lte=365"`
+	Preferred    bool
+}
+
+type RemoveProductSupplierRequest struct {
+	ProductID  int64 `validate:"required"`
+	SupplierID int64 `validate:"required"`
+}
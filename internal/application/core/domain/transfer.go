@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+type TransferStatus string
+
+const (
+	TransferRequested TransferStatus = "requested"
+	TransferInTransit TransferStatus = "in_transit"
+	TransferReceived  TransferStatus = "received"
+	TransferCancelled TransferStatus = "cancelled"
+)
+
+// CanTransitionTo reports whether a transfer in status s may move to next.
+// Only a requested transfer can be cancelled, as dispatched stock has
+// already left the source warehouse.
+func (s TransferStatus) CanTransitionTo(next TransferStatus) bool {
+	switch s {
+	case TransferRequested:
+		return next == TransferInTransit || next == TransferCancelled
+	case TransferInTransit:
+		return next == TransferReceived
+	default:
+		return false
+	}
+}
+
+type StockTransfer struct {
+	ID              int64
+	ProductID       int64
+	FromWarehouseID int64
+	ToWarehouseID   int64
+	Quantity        int
+	Status          TransferStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type CreateStockTransferRequest struct {
+	ProductID       int64 `validate:"required"`
+	FromWarehouseID int64 `validate:"required"`
+	ToWarehouseID   int64 `validate:"required,nefield=FromWarehouseID"`
+	Quantity        int   `validate:"gt=0"`
+}
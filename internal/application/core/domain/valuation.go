@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+// CostMethod decides the unit cost of the units leaving stock.
+type CostMethod string
+
+const (
+	// CostFIFO consumes the oldest cost layers first.
+	CostFIFO CostMethod = "fifo"
+	// CostWeightedAverage folds every receipt into a single layer per
+	// product and currency, priced at the average unit cost.
+	CostWeightedAverage CostMethod = "weighted_average"
+)
+
+func (m CostMethod) IsValid() bool {
+	return m == CostFIFO || m == CostWeightedAverage
+}
+
+// CostLayer is a quantity of a product bought at the same unit cost, as
+// recorded by a goods receipt. RemainingQuantity is what is left of it in
+// stock. Units that came in without a cost, such as the opening stock,
+// manual receipts, returns and positive adjustments, get a layer with a nil
+// UnitCost, so that the units leaving stock keep the order they came in.
+type CostLayer struct {
+	ID                int64
+	ProductID         int64
+	CurrencyCode      string
+	Quantity          int
+	RemainingQuantity int
+	UnitCost          *float64
+	Reference         string
+	CreatedAt         time.Time
+}
+
+// ValuationLine is the cost of the stock on hand of a subcategory in one
+// currency. Quantity counts the units valued at cost, UncostedQuantity
+// those without a known cost.
+type ValuationLine struct {
+	MainCategory     string
+	SubCategory      string
+	CurrencyCode     string
+	Quantity         int
+	UncostedQuantity int
+	Value            float64
+}
+
+type ValuationTotal struct {
+	CurrencyCode     string
+	Quantity         int
+	UncostedQuantity int
+	Value            float64
+}
+
+// ValuationReport values the stock on hand at cost. Units without a known
+// cost are counted apart and add nothing to the value. Held units are
+// valued until their reservation is confirmed.
+type ValuationReport struct {
+	Method CostMethod
+	Lines  []ValuationLine
+	Totals []ValuationTotal
+}
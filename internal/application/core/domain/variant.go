@@ -0,0 +1,62 @@
+package domain
+
+// Variant is a sellable version of a product, such as a size and colour of
+// a shirt, told apart from its siblings by Options. A nil price means the
+// variant sells at the price of its product.
+type Variant struct {
+	ID            int64
+	ProductID     int64
+	SKU           string
+	Options       map[string]string
+	StockNumber   int
+	DiscountPrice *float64
+	ActualPrice   *float64
+	Version       int64
+}
+
+type CreateVariantRequest struct {
+	ProductID     int64             `validate:"required"`
+	SKU           string            `validate:"omitempty,max=64,sku"`
+	Options       map[string]string `validate:"required,min=1,max=8,dive,keys,required,max=32,endkeys,required,max=64"`
+	StockNumber   int               `validate:"gte=0"`
+	DiscountPrice *float64          `validate:"omitempty,gte=0"`
+	ActualPrice   *float64          `validate:"omitempty,gt=0"`
+}
+
+// UpdateVariantRequest replaces every field of the variant but its product.
+type UpdateVariantRequest struct {
+	ID            int64             `validate:"required"`
+	SKU           string            `validate:"omitempty,max=64,sku"`
+	Options       map[string]string `validate:"required,min=1,max=8,dive,keys,required,max=32,endkeys,required,max=64"`
+	StockNumber   int               `validate:"gte=0"`
+	DiscountPrice *float64          `validate:"omitempty,gte=0"`
+	ActualPrice   *float64          `validate:"omitempty,gt=0"`
+	Version       int64             `validate:"gte=1"`
+}
+
+type DeleteVariantRequest struct {
+	ID      int64 `validate:"required"`
+	Version int64 `validate:"gte=1"`
+}
+
+// SumVariantStocks returns the total number of units across variants.
+func SumVariantStocks(variants []Variant) int {
+	total := 0
+	for _, v := range variants {
+		total += v.StockNumber
+	}
+
+	return total
+}
+
+// ConvertPrices multiplies the prices of the variant that are set by rate.
+func (v *Variant) ConvertPrices(rate float64) {
+	if v.ActualPrice != nil {
+		price := *v.ActualPrice * rate
+		v.ActualPrice = &price
+	}
+	if v.DiscountPrice != nil {
+		price := *v.DiscountPrice * rate
+		v.DiscountPrice = &price
+	}
+}
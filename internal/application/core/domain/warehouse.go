@@ -0,0 +1,48 @@
+package domain
+
+type Warehouse struct {
+	ID   int64
+	Code string
+	Name string
+}
+
+type CreateWarehouseRequest struct {
+	Code string `validate:"required,max=32"`
+	Name string `validate:"required"`
+}
+
+// WarehouseStock is the number of units of a product held in one warehouse.
+type WarehouseStock struct {
+	WarehouseID   int64
+	WarehouseCode string
+	WarehouseName string
+	StockNumber   int
+}
+
+// ProductStock breaks the stock of a product down by warehouse.
+// StockNumber is the sellable stock of the product and WarehouseStockNumber
+// is the sum of the units held across Warehouses. Stock changes made without
+// a warehouse only move StockNumber, so the two can differ.
+type ProductStock struct {
+	ProductID            int64
+	StockNumber          int
+	WarehouseStockNumber int
+	Warehouses           []WarehouseStock
+}
+
+type AdjustWarehouseStockRequest struct {
+	ProductID   int64               `validate:"required"`
+	WarehouseID int64               `validate:"required"`
+	Delta       int                 `validate:"required"`
+	Reason      StockMovementReason `validate:"required,oneof=receipt sale return adjustment damage"`
+}
+
+// SumWarehouseStocks returns the total number of units held across stocks.
+func SumWarehouseStocks(stocks []WarehouseStock) int {
+	total := 0
+	for _, s := range stocks {
+		total += s.StockNumber
+	}
+
+	return total
+}
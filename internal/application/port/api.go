@@ -2,14 +2,91 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/ebisaan/inventory/internal/application/core/domain"
 )
 
 type API interface {
-	GetProductByID(ctx context.Context, id int64) (*domain.Product, error)
+	GetProductByID(ctx context.Context, id int64, opts ...domain.ReadOptions) (*domain.Product, error)
+	GetProductBySKU(ctx context.Context, sku string, opts ...domain.ReadOptions) (*domain.Product, error)
+	GetProductByBarcode(ctx context.Context, barcode string, opts ...domain.ReadOptions) (*domain.Product, error)
 	GetProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error)
+	GetProductsByIDs(ctx context.Context, ids []int64) (products []*domain.Product, missingIDs []int64, err error)
+	SearchProducts(ctx context.Context, query string, filter domain.Filter) ([]*domain.Product, domain.Metadata, error)
 	CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (id int64, err error)
 	UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error
 	DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error
+	RestoreProduct(ctx context.Context, id int64) error
+	GetDeletedProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error)
+	PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error)
+	GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error)
+	GetProductAsOf(ctx context.Context, id int64, at time.Time) (*domain.Product, error)
+	ImportProducts(ctx context.Context, reqs []*domain.CreateProductRequest) (*domain.ImportReport, error)
+	ExportProducts(ctx context.Context, filter domain.Filter, fn func([]*domain.Product) error) error
+
+	AdjustStock(ctx context.Context, productID int64, delta int, reason domain.StockMovementReason) (*domain.StockLevel, error)
+
+	GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) ([]*domain.StockMovement, domain.Metadata, error)
+	GetLowStockProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error)
+
+	CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (id int64, err error)
+	GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error)
+	GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error)
+	AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)
+	GetStockDiscrepancies(ctx context.Context, filter domain.Filter) ([]*domain.ProductStock, domain.Metadata, error)
+
+	CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (id int64, err error)
+	GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error)
+	DispatchStockTransfer(ctx context.Context, id int64) error
+	ReceiveStockTransfer(ctx context.Context, id int64) error
+	CancelStockTransfer(ctx context.Context, id int64) error
+
+	CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (id int64, err error)
+	GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error)
+	GetSuppliers(ctx context.Context) ([]*domain.Supplier, error)
+	UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error
+	DeleteSupplier(ctx context.Context, id int64) error
+	GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error)
+	SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) error
+	RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error
+	CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (id int64, err error)
+	GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error)
+	ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest) (*domain.PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, id int64) error
+
+	GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error)
+	GetInventoryValuation(ctx context.Context) (*domain.ValuationReport, error)
+
+	ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (*domain.Reservation, error)
+	GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error)
+	ConfirmReservation(ctx context.Context, orderID string) error
+	ReleaseReservation(ctx context.Context, orderID string) error
+	ReleaseExpiredReservations(ctx context.Context) (int64, error)
+
+	GetCategories(ctx context.Context) ([]*domain.MainCategory, error)
+	CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (id int64, err error)
+	UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error
+	DeleteMainCategory(ctx context.Context, id int64) error
+	CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (id int64, err error)
+	UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error
+	DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) error
+
+	GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error)
+	CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (id int64, err error)
+	DisableCurrency(ctx context.Context, code string) error
+	EnableCurrency(ctx context.Context, code string) error
+	SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error
+	GetExchangeRate(ctx context.Context, base, quote string, at time.Time) (*domain.ExchangeRate, error)
+
+	GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error)
+	CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (id int64, err error)
+	UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) error
+	DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) error
+
+	RelayEvents(ctx context.Context) (int64, error)
+	PurgeOutboxEvents(ctx context.Context, retention time.Duration) (int64, error)
+
+	WatchProducts(ctx context.Context, req *domain.WatchProductsRequest, fn func(*domain.ProductChangeEvent) error) error
+	RunProductChangeFeed(ctx context.Context) error
 }
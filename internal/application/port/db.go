@@ -2,16 +2,94 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/ebisaan/inventory/internal/application/core/domain"
 )
 
 type DB interface {
 	GetProductByID(ctx context.Context, id int64) (*domain.Product, error)
+	GetProductBySKU(ctx context.Context, sku string) (*domain.Product, error)
+	GetProductByBarcode(ctx context.Context, barcode string) (*domain.Product, error)
 	GetProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error)
+	GetProductsByIDs(ctx context.Context, ids []int64) ([]*domain.Product, error)
+	SearchProducts(ctx context.Context, query string, filter domain.Filter) (int64, []*domain.Product, error)
 	CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (id int64, err error)
 	UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error
 	DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error
+	RestoreProduct(ctx context.Context, id int64) error
+	GetDeletedProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error)
+	PurgeDeletedProducts(ctx context.Context, before time.Time, limit int) (int64, error)
+	GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error)
+	GetProductSnapshot(ctx context.Context, productID int64, at time.Time) (*domain.ProductSnapshot, error)
 	IsSubCategoryExists(ctx context.Context, subCategory string) (bool, error)
 	IsCurrencyCodeExists(ctx context.Context, currencyCode string) (bool, error)
+	CreateProducts(ctx context.Context, reqs []*domain.CreateProductRequest) ([]int64, error)
+	FindMissingSubCategories(ctx context.Context, names []string) ([]string, error)
+	FindMissingCurrencyCodes(ctx context.Context, codes []string) ([]string, error)
+	FindTakenIdentifiers(ctx context.Context, skus, barcodes []string) (takenSKUs, takenBarcodes []string, err error)
+
+	AdjustStock(ctx context.Context, req *domain.AdjustStockRequest) (*domain.StockLevel, error)
+
+	GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) (int64, []*domain.StockMovement, error)
+	GetLowStockProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error)
+
+	CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (id int64, err error)
+	GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error)
+	GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error)
+	AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)
+	GetStockDiscrepancies(ctx context.Context, filter domain.Filter) (int64, []*domain.ProductStock, error)
+
+	CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (id int64, err error)
+	GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error)
+	DispatchStockTransfer(ctx context.Context, id int64) error
+	ReceiveStockTransfer(ctx context.Context, id int64) error
+	CancelStockTransfer(ctx context.Context, id int64) error
+
+	CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (id int64, err error)
+	GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error)
+	GetSuppliers(ctx context.Context) ([]*domain.Supplier, error)
+	UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error
+	DeleteSupplier(ctx context.Context, id int64) error
+	GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error)
+	SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) error
+	RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error
+	CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (id int64, err error)
+	GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error)
+	ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest, method domain.CostMethod) (*domain.PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, id int64) error
+
+	GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error)
+	GetValuation(ctx context.Context) ([]domain.ValuationLine, error)
+
+	ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (*domain.Reservation, error)
+	GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error)
+	ConfirmReservation(ctx context.Context, orderID string) error
+	ReleaseReservation(ctx context.Context, orderID string) error
+	ReleaseExpiredReservations(ctx context.Context, before time.Time, limit int) (int64, error)
+
+	GetCategories(ctx context.Context) ([]*domain.MainCategory, error)
+	CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (id int64, err error)
+	UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error
+	DeleteMainCategory(ctx context.Context, id int64) error
+	CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (id int64, err error)
+	UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error
+	DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) error
+
+	GetCurrency(ctx context.Context, code string) (*domain.Currency, error)
+	GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error)
+	CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (id int64, err error)
+	SetCurrencyDisabled(ctx context.Context, code string, disabled bool) error
+	SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error
+	GetExchangeRate(ctx context.Context, base, quote string, at time.Time) (*domain.ExchangeRate, error)
+
+	GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error)
+	CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (id int64, err error)
+	UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) error
+	DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) error
+
+	RelayEvents(ctx context.Context, limit int, publish func([]*domain.Event) error) (int64, error)
+	NotifyEvents(ctx context.Context, limit int, notify func([]*domain.Event) error) (int64, error)
+	PurgeOutboxEvents(ctx context.Context, before time.Time, unpublished, unnotified bool, limit int) (int64, error)
+	ListenProductChanges(ctx context.Context, fn func(*domain.ProductChangeEvent)) error
 }
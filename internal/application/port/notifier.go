@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+type Notifier interface {
+	// NotifyLowStock tells whoever restocks the product that it runs low.
+	// The alert is notified again when it fails.
+	NotifyLowStock(ctx context.Context, alert *domain.LowStockAlert) error
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/ebisaan/inventory/internal/application/core/domain"
+)
+
+type Publisher interface {
+	// Publish delivers the events in order. The events are published again
+	// when it fails.
+	Publish(ctx context.Context, events []*domain.Event) error
+}
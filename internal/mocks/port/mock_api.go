@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/ebisaan/inventory/internal/application/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockAPI is an autogenerated mock type for the API type
@@ -22,27 +24,29 @@ func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
 	return &MockAPI_Expecter{mock: &_m.Mock}
 }
 
-// CreateProduct provides a mock function with given fields: ctx, req
-func (_m *MockAPI) CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (int64, error) {
-	ret := _m.Called(ctx, req)
+// AdjustStock provides a mock function with given fields: ctx, productID, delta, reason
+func (_m *MockAPI) AdjustStock(ctx context.Context, productID int64, delta int, reason domain.StockMovementReason) (*domain.StockLevel, error) {
+	ret := _m.Called(ctx, productID, delta, reason)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProduct")
+		panic("no return value specified for AdjustStock")
 	}
 
-	var r0 int64
+	var r0 *domain.StockLevel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) (int64, error)); ok {
-		return rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, domain.StockMovementReason) (*domain.StockLevel, error)); ok {
+		return rf(ctx, productID, delta, reason)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) int64); ok {
-		r0 = rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, domain.StockMovementReason) *domain.StockLevel); ok {
+		r0 = rf(ctx, productID, delta, reason)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StockLevel)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateProductRequest) error); ok {
-		r1 = rf(ctx, req)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, domain.StockMovementReason) error); ok {
+		r1 = rf(ctx, productID, delta, reason)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,58 +54,60 @@ func (_m *MockAPI) CreateProduct(ctx context.Context, req *domain.CreateProductR
 	return r0, r1
 }
 
-// MockAPI_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
-type MockAPI_CreateProduct_Call struct {
+// MockAPI_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type MockAPI_AdjustStock_Call struct {
 	*mock.Call
 }
 
-// CreateProduct is a helper method to define mock.On call
+// AdjustStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.CreateProductRequest
-func (_e *MockAPI_Expecter) CreateProduct(ctx interface{}, req interface{}) *MockAPI_CreateProduct_Call {
-	return &MockAPI_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, req)}
+//   - productID int64
+//   - delta int
+//   - reason domain.StockMovementReason
+func (_e *MockAPI_Expecter) AdjustStock(ctx interface{}, productID interface{}, delta interface{}, reason interface{}) *MockAPI_AdjustStock_Call {
+	return &MockAPI_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, productID, delta, reason)}
 }
 
-func (_c *MockAPI_CreateProduct_Call) Run(run func(ctx context.Context, req *domain.CreateProductRequest)) *MockAPI_CreateProduct_Call {
+func (_c *MockAPI_AdjustStock_Call) Run(run func(ctx context.Context, productID int64, delta int, reason domain.StockMovementReason)) *MockAPI_AdjustStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.CreateProductRequest))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(domain.StockMovementReason))
 	})
 	return _c
 }
 
-func (_c *MockAPI_CreateProduct_Call) Return(id int64, err error) *MockAPI_CreateProduct_Call {
-	_c.Call.Return(id, err)
+func (_c *MockAPI_AdjustStock_Call) Return(_a0 *domain.StockLevel, _a1 error) *MockAPI_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAPI_CreateProduct_Call) RunAndReturn(run func(context.Context, *domain.CreateProductRequest) (int64, error)) *MockAPI_CreateProduct_Call {
+func (_c *MockAPI_AdjustStock_Call) RunAndReturn(run func(context.Context, int64, int, domain.StockMovementReason) (*domain.StockLevel, error)) *MockAPI_AdjustStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProductByID provides a mock function with given fields: ctx, id
-func (_m *MockAPI) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
-	ret := _m.Called(ctx, id)
+// AdjustWarehouseStock provides a mock function with given fields: ctx, req
+func (_m *MockAPI) AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProductByID")
+		panic("no return value specified for AdjustWarehouseStock")
 	}
 
-	var r0 *domain.Product
+	var r0 *domain.WarehouseStock
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Product, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Product); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustWarehouseStockRequest) *domain.WarehouseStock); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).(*domain.WarehouseStock)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.AdjustWarehouseStockRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,144 +115,3754 @@ func (_m *MockAPI) GetProductByID(ctx context.Context, id int64) (*domain.Produc
 	return r0, r1
 }
 
-// MockAPI_GetProductByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByID'
-type MockAPI_GetProductByID_Call struct {
+// MockAPI_AdjustWarehouseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustWarehouseStock'
+type MockAPI_AdjustWarehouseStock_Call struct {
 	*mock.Call
 }
 
-// GetProductByID is a helper method to define mock.On call
+// AdjustWarehouseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.AdjustWarehouseStockRequest
+func (_e *MockAPI_Expecter) AdjustWarehouseStock(ctx interface{}, req interface{}) *MockAPI_AdjustWarehouseStock_Call {
+	return &MockAPI_AdjustWarehouseStock_Call{Call: _e.mock.On("AdjustWarehouseStock", ctx, req)}
+}
+
+func (_c *MockAPI_AdjustWarehouseStock_Call) Run(run func(ctx context.Context, req *domain.AdjustWarehouseStockRequest)) *MockAPI_AdjustWarehouseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AdjustWarehouseStockRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_AdjustWarehouseStock_Call) Return(_a0 *domain.WarehouseStock, _a1 error) *MockAPI_AdjustWarehouseStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_AdjustWarehouseStock_Call) RunAndReturn(run func(context.Context, *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)) *MockAPI_AdjustWarehouseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *MockAPI) CancelPurchaseOrder(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelPurchaseOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_CancelPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPurchaseOrder'
+type MockAPI_CancelPurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// CancelPurchaseOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockAPI_Expecter) GetProductByID(ctx interface{}, id interface{}) *MockAPI_GetProductByID_Call {
-	return &MockAPI_GetProductByID_Call{Call: _e.mock.On("GetProductByID", ctx, id)}
+func (_e *MockAPI_Expecter) CancelPurchaseOrder(ctx interface{}, id interface{}) *MockAPI_CancelPurchaseOrder_Call {
+	return &MockAPI_CancelPurchaseOrder_Call{Call: _e.mock.On("CancelPurchaseOrder", ctx, id)}
 }
 
-func (_c *MockAPI_GetProductByID_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_GetProductByID_Call {
+func (_c *MockAPI_CancelPurchaseOrder_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_CancelPurchaseOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockAPI_GetProductByID_Call) Return(_a0 *domain.Product, _a1 error) *MockAPI_GetProductByID_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockAPI_CancelPurchaseOrder_Call) Return(_a0 error) *MockAPI_CancelPurchaseOrder_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAPI_GetProductByID_Call) RunAndReturn(run func(context.Context, int64) (*domain.Product, error)) *MockAPI_GetProductByID_Call {
+func (_c *MockAPI_CancelPurchaseOrder_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_CancelPurchaseOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProducts provides a mock function with given fields: ctx, filter
-func (_m *MockAPI) GetProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
-	ret := _m.Called(ctx, filter)
+// CancelStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockAPI) CancelStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProducts")
+		panic("no return value specified for CancelStockTransfer")
 	}
 
-	var r0 []*domain.Product
-	var r1 domain.Metadata
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)); ok {
-		return rf(ctx, filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []*domain.Product); ok {
-		r0 = rf(ctx, filter)
+
+	return r0
+}
+
+// MockAPI_CancelStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelStockTransfer'
+type MockAPI_CancelStockTransfer_Call struct {
+	*mock.Call
+}
+
+// CancelStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) CancelStockTransfer(ctx interface{}, id interface{}) *MockAPI_CancelStockTransfer_Call {
+	return &MockAPI_CancelStockTransfer_Call{Call: _e.mock.On("CancelStockTransfer", ctx, id)}
+}
+
+func (_c *MockAPI_CancelStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_CancelStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CancelStockTransfer_Call) Return(_a0 error) *MockAPI_CancelStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_CancelStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_CancelStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmReservation provides a mock function with given fields: ctx, orderID
+func (_m *MockAPI) ConfirmReservation(ctx context.Context, orderID string) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Product)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) domain.Metadata); ok {
-		r1 = rf(ctx, filter)
+	return r0
+}
+
+// MockAPI_ConfirmReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmReservation'
+type MockAPI_ConfirmReservation_Call struct {
+	*mock.Call
+}
+
+// ConfirmReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+func (_e *MockAPI_Expecter) ConfirmReservation(ctx interface{}, orderID interface{}) *MockAPI_ConfirmReservation_Call {
+	return &MockAPI_ConfirmReservation_Call{Call: _e.mock.On("ConfirmReservation", ctx, orderID)}
+}
+
+func (_c *MockAPI_ConfirmReservation_Call) Run(run func(ctx context.Context, orderID string)) *MockAPI_ConfirmReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ConfirmReservation_Call) Return(_a0 error) *MockAPI_ConfirmReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ConfirmReservation_Call) RunAndReturn(run func(context.Context, string) error) *MockAPI_ConfirmReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCurrency provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCurrency")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCurrencyRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCurrencyRequest) int64); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r1 = ret.Get(1).(domain.Metadata)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
-		r2 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateCurrencyRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockAPI_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
-type MockAPI_GetProducts_Call struct {
+// MockAPI_CreateCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrency'
+type MockAPI_CreateCurrency_Call struct {
 	*mock.Call
 }
 
-// GetProducts is a helper method to define mock.On call
+// CreateCurrency is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.Filter
-func (_e *MockAPI_Expecter) GetProducts(ctx interface{}, filter interface{}) *MockAPI_GetProducts_Call {
-	return &MockAPI_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, filter)}
+//   - req *domain.CreateCurrencyRequest
+func (_e *MockAPI_Expecter) CreateCurrency(ctx interface{}, req interface{}) *MockAPI_CreateCurrency_Call {
+	return &MockAPI_CreateCurrency_Call{Call: _e.mock.On("CreateCurrency", ctx, req)}
 }
 
-func (_c *MockAPI_GetProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockAPI_GetProducts_Call {
+func (_c *MockAPI_CreateCurrency_Call) Run(run func(ctx context.Context, req *domain.CreateCurrencyRequest)) *MockAPI_CreateCurrency_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Filter))
+		run(args[0].(context.Context), args[1].(*domain.CreateCurrencyRequest))
 	})
 	return _c
 }
 
-func (_c *MockAPI_GetProducts_Call) Return(_a0 []*domain.Product, _a1 domain.Metadata, _a2 error) *MockAPI_GetProducts_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockAPI_CreateCurrency_Call) Return(id int64, err error) *MockAPI_CreateCurrency_Call {
+	_c.Call.Return(id, err)
 	return _c
 }
 
-func (_c *MockAPI_GetProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)) *MockAPI_GetProducts_Call {
+func (_c *MockAPI_CreateCurrency_Call) RunAndReturn(run func(context.Context, *domain.CreateCurrencyRequest) (int64, error)) *MockAPI_CreateCurrency_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProduct provides a mock function with given fields: ctx, req
-func (_m *MockAPI) UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error {
+// CreateMainCategory provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (int64, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProduct")
+		panic("no return value specified for CreateMainCategory")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateProductRequest) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateMainCategoryRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateMainCategoryRequest) int64); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateMainCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockAPI_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
-type MockAPI_UpdateProduct_Call struct {
+// MockAPI_CreateMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMainCategory'
+type MockAPI_CreateMainCategory_Call struct {
 	*mock.Call
 }
 
-// UpdateProduct is a helper method to define mock.On call
+// CreateMainCategory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.UpdateProductRequest
-func (_e *MockAPI_Expecter) UpdateProduct(ctx interface{}, req interface{}) *MockAPI_UpdateProduct_Call {
-	return &MockAPI_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, req)}
+//   - req *domain.CreateMainCategoryRequest
+func (_e *MockAPI_Expecter) CreateMainCategory(ctx interface{}, req interface{}) *MockAPI_CreateMainCategory_Call {
+	return &MockAPI_CreateMainCategory_Call{Call: _e.mock.On("CreateMainCategory", ctx, req)}
 }
 
-func (_c *MockAPI_UpdateProduct_Call) Run(run func(ctx context.Context, req *domain.UpdateProductRequest)) *MockAPI_UpdateProduct_Call {
+func (_c *MockAPI_CreateMainCategory_Call) Run(run func(ctx context.Context, req *domain.CreateMainCategoryRequest)) *MockAPI_CreateMainCategory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.UpdateProductRequest))
+		run(args[0].(context.Context), args[1].(*domain.CreateMainCategoryRequest))
 	})
 	return _c
 }
 
-func (_c *MockAPI_UpdateProduct_Call) Return(_a0 error) *MockAPI_UpdateProduct_Call {
-	_c.Call.Return(_a0)
+func (_c *MockAPI_CreateMainCategory_Call) Return(id int64, err error) *MockAPI_CreateMainCategory_Call {
+	_c.Call.Return(id, err)
 	return _c
 }
 
-func (_c *MockAPI_UpdateProduct_Call) RunAndReturn(run func(context.Context, *domain.UpdateProductRequest) error) *MockAPI_UpdateProduct_Call {
+func (_c *MockAPI_CreateMainCategory_Call) RunAndReturn(run func(context.Context, *domain.CreateMainCategoryRequest) (int64, error)) *MockAPI_CreateMainCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProduct provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateProductRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
+type MockAPI_CreateProduct_Call struct {
+	*mock.Call
+}
+
+// CreateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateProductRequest
+func (_e *MockAPI_Expecter) CreateProduct(ctx interface{}, req interface{}) *MockAPI_CreateProduct_Call {
+	return &MockAPI_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, req)}
+}
+
+func (_c *MockAPI_CreateProduct_Call) Run(run func(ctx context.Context, req *domain.CreateProductRequest)) *MockAPI_CreateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateProduct_Call) Return(id int64, err error) *MockAPI_CreateProduct_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateProduct_Call) RunAndReturn(run func(context.Context, *domain.CreateProductRequest) (int64, error)) *MockAPI_CreateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePurchaseOrder provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePurchaseOrder")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreatePurchaseOrderRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreatePurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePurchaseOrder'
+type MockAPI_CreatePurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// CreatePurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreatePurchaseOrderRequest
+func (_e *MockAPI_Expecter) CreatePurchaseOrder(ctx interface{}, req interface{}) *MockAPI_CreatePurchaseOrder_Call {
+	return &MockAPI_CreatePurchaseOrder_Call{Call: _e.mock.On("CreatePurchaseOrder", ctx, req)}
+}
+
+func (_c *MockAPI_CreatePurchaseOrder_Call) Run(run func(ctx context.Context, req *domain.CreatePurchaseOrderRequest)) *MockAPI_CreatePurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreatePurchaseOrderRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreatePurchaseOrder_Call) Return(id int64, err error) *MockAPI_CreatePurchaseOrder_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreatePurchaseOrder_Call) RunAndReturn(run func(context.Context, *domain.CreatePurchaseOrderRequest) (int64, error)) *MockAPI_CreatePurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStockTransfer provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockTransfer")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateStockTransferRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateStockTransferRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateStockTransferRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStockTransfer'
+type MockAPI_CreateStockTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateStockTransferRequest
+func (_e *MockAPI_Expecter) CreateStockTransfer(ctx interface{}, req interface{}) *MockAPI_CreateStockTransfer_Call {
+	return &MockAPI_CreateStockTransfer_Call{Call: _e.mock.On("CreateStockTransfer", ctx, req)}
+}
+
+func (_c *MockAPI_CreateStockTransfer_Call) Run(run func(ctx context.Context, req *domain.CreateStockTransferRequest)) *MockAPI_CreateStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateStockTransferRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateStockTransfer_Call) Return(id int64, err error) *MockAPI_CreateStockTransfer_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateStockTransfer_Call) RunAndReturn(run func(context.Context, *domain.CreateStockTransferRequest) (int64, error)) *MockAPI_CreateStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSubCategoryRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSubCategoryRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSubCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubCategory'
+type MockAPI_CreateSubCategory_Call struct {
+	*mock.Call
+}
+
+// CreateSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSubCategoryRequest
+func (_e *MockAPI_Expecter) CreateSubCategory(ctx interface{}, req interface{}) *MockAPI_CreateSubCategory_Call {
+	return &MockAPI_CreateSubCategory_Call{Call: _e.mock.On("CreateSubCategory", ctx, req)}
+}
+
+func (_c *MockAPI_CreateSubCategory_Call) Run(run func(ctx context.Context, req *domain.CreateSubCategoryRequest)) *MockAPI_CreateSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateSubCategory_Call) Return(id int64, err error) *MockAPI_CreateSubCategory_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateSubCategory_Call) RunAndReturn(run func(context.Context, *domain.CreateSubCategoryRequest) (int64, error)) *MockAPI_CreateSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSupplier provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSupplier")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSupplierRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSupplier'
+type MockAPI_CreateSupplier_Call struct {
+	*mock.Call
+}
+
+// CreateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSupplierRequest
+func (_e *MockAPI_Expecter) CreateSupplier(ctx interface{}, req interface{}) *MockAPI_CreateSupplier_Call {
+	return &MockAPI_CreateSupplier_Call{Call: _e.mock.On("CreateSupplier", ctx, req)}
+}
+
+func (_c *MockAPI_CreateSupplier_Call) Run(run func(ctx context.Context, req *domain.CreateSupplierRequest)) *MockAPI_CreateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateSupplier_Call) Return(id int64, err error) *MockAPI_CreateSupplier_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateSupplier_Call) RunAndReturn(run func(context.Context, *domain.CreateSupplierRequest) (int64, error)) *MockAPI_CreateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateVariant provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariant")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateVariantRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateVariantRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateVariantRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVariant'
+type MockAPI_CreateVariant_Call struct {
+	*mock.Call
+}
+
+// CreateVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateVariantRequest
+func (_e *MockAPI_Expecter) CreateVariant(ctx interface{}, req interface{}) *MockAPI_CreateVariant_Call {
+	return &MockAPI_CreateVariant_Call{Call: _e.mock.On("CreateVariant", ctx, req)}
+}
+
+func (_c *MockAPI_CreateVariant_Call) Run(run func(ctx context.Context, req *domain.CreateVariantRequest)) *MockAPI_CreateVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateVariant_Call) Return(id int64, err error) *MockAPI_CreateVariant_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateVariant_Call) RunAndReturn(run func(context.Context, *domain.CreateVariantRequest) (int64, error)) *MockAPI_CreateVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, req
+func (_m *MockAPI) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWarehouse")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateWarehouseRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateWarehouseRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateWarehouseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_CreateWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWarehouse'
+type MockAPI_CreateWarehouse_Call struct {
+	*mock.Call
+}
+
+// CreateWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateWarehouseRequest
+func (_e *MockAPI_Expecter) CreateWarehouse(ctx interface{}, req interface{}) *MockAPI_CreateWarehouse_Call {
+	return &MockAPI_CreateWarehouse_Call{Call: _e.mock.On("CreateWarehouse", ctx, req)}
+}
+
+func (_c *MockAPI_CreateWarehouse_Call) Run(run func(ctx context.Context, req *domain.CreateWarehouseRequest)) *MockAPI_CreateWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateWarehouseRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_CreateWarehouse_Call) Return(id int64, err error) *MockAPI_CreateWarehouse_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockAPI_CreateWarehouse_Call) RunAndReturn(run func(context.Context, *domain.CreateWarehouseRequest) (int64, error)) *MockAPI_CreateWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMainCategory provides a mock function with given fields: ctx, id
+func (_m *MockAPI) DeleteMainCategory(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMainCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DeleteMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMainCategory'
+type MockAPI_DeleteMainCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteMainCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) DeleteMainCategory(ctx interface{}, id interface{}) *MockAPI_DeleteMainCategory_Call {
+	return &MockAPI_DeleteMainCategory_Call{Call: _e.mock.On("DeleteMainCategory", ctx, id)}
+}
+
+func (_c *MockAPI_DeleteMainCategory_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_DeleteMainCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DeleteMainCategory_Call) Return(_a0 error) *MockAPI_DeleteMainCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DeleteMainCategory_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_DeleteMainCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, req
+func (_m *MockAPI) DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockAPI_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteProductRequest
+func (_e *MockAPI_Expecter) DeleteProduct(ctx interface{}, req interface{}) *MockAPI_DeleteProduct_Call {
+	return &MockAPI_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, req)}
+}
+
+func (_c *MockAPI_DeleteProduct_Call) Run(run func(ctx context.Context, req *domain.DeleteProductRequest)) *MockAPI_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DeleteProduct_Call) Return(_a0 error) *MockAPI_DeleteProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DeleteProduct_Call) RunAndReturn(run func(context.Context, *domain.DeleteProductRequest) error) *MockAPI_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockAPI) DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteSubCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DeleteSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubCategory'
+type MockAPI_DeleteSubCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteSubCategoryRequest
+func (_e *MockAPI_Expecter) DeleteSubCategory(ctx interface{}, req interface{}) *MockAPI_DeleteSubCategory_Call {
+	return &MockAPI_DeleteSubCategory_Call{Call: _e.mock.On("DeleteSubCategory", ctx, req)}
+}
+
+func (_c *MockAPI_DeleteSubCategory_Call) Run(run func(ctx context.Context, req *domain.DeleteSubCategoryRequest)) *MockAPI_DeleteSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DeleteSubCategory_Call) Return(_a0 error) *MockAPI_DeleteSubCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DeleteSubCategory_Call) RunAndReturn(run func(context.Context, *domain.DeleteSubCategoryRequest) error) *MockAPI_DeleteSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSupplier provides a mock function with given fields: ctx, id
+func (_m *MockAPI) DeleteSupplier(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DeleteSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSupplier'
+type MockAPI_DeleteSupplier_Call struct {
+	*mock.Call
+}
+
+// DeleteSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) DeleteSupplier(ctx interface{}, id interface{}) *MockAPI_DeleteSupplier_Call {
+	return &MockAPI_DeleteSupplier_Call{Call: _e.mock.On("DeleteSupplier", ctx, id)}
+}
+
+func (_c *MockAPI_DeleteSupplier_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_DeleteSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DeleteSupplier_Call) Return(_a0 error) *MockAPI_DeleteSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DeleteSupplier_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_DeleteSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteVariant provides a mock function with given fields: ctx, req
+func (_m *MockAPI) DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteVariantRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DeleteVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteVariant'
+type MockAPI_DeleteVariant_Call struct {
+	*mock.Call
+}
+
+// DeleteVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteVariantRequest
+func (_e *MockAPI_Expecter) DeleteVariant(ctx interface{}, req interface{}) *MockAPI_DeleteVariant_Call {
+	return &MockAPI_DeleteVariant_Call{Call: _e.mock.On("DeleteVariant", ctx, req)}
+}
+
+func (_c *MockAPI_DeleteVariant_Call) Run(run func(ctx context.Context, req *domain.DeleteVariantRequest)) *MockAPI_DeleteVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DeleteVariant_Call) Return(_a0 error) *MockAPI_DeleteVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DeleteVariant_Call) RunAndReturn(run func(context.Context, *domain.DeleteVariantRequest) error) *MockAPI_DeleteVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableCurrency provides a mock function with given fields: ctx, code
+func (_m *MockAPI) DisableCurrency(ctx context.Context, code string) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableCurrency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DisableCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableCurrency'
+type MockAPI_DisableCurrency_Call struct {
+	*mock.Call
+}
+
+// DisableCurrency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockAPI_Expecter) DisableCurrency(ctx interface{}, code interface{}) *MockAPI_DisableCurrency_Call {
+	return &MockAPI_DisableCurrency_Call{Call: _e.mock.On("DisableCurrency", ctx, code)}
+}
+
+func (_c *MockAPI_DisableCurrency_Call) Run(run func(ctx context.Context, code string)) *MockAPI_DisableCurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DisableCurrency_Call) Return(_a0 error) *MockAPI_DisableCurrency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DisableCurrency_Call) RunAndReturn(run func(context.Context, string) error) *MockAPI_DisableCurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DispatchStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockAPI) DispatchStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchStockTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_DispatchStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchStockTransfer'
+type MockAPI_DispatchStockTransfer_Call struct {
+	*mock.Call
+}
+
+// DispatchStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) DispatchStockTransfer(ctx interface{}, id interface{}) *MockAPI_DispatchStockTransfer_Call {
+	return &MockAPI_DispatchStockTransfer_Call{Call: _e.mock.On("DispatchStockTransfer", ctx, id)}
+}
+
+func (_c *MockAPI_DispatchStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_DispatchStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_DispatchStockTransfer_Call) Return(_a0 error) *MockAPI_DispatchStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_DispatchStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_DispatchStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableCurrency provides a mock function with given fields: ctx, code
+func (_m *MockAPI) EnableCurrency(ctx context.Context, code string) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableCurrency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_EnableCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableCurrency'
+type MockAPI_EnableCurrency_Call struct {
+	*mock.Call
+}
+
+// EnableCurrency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockAPI_Expecter) EnableCurrency(ctx interface{}, code interface{}) *MockAPI_EnableCurrency_Call {
+	return &MockAPI_EnableCurrency_Call{Call: _e.mock.On("EnableCurrency", ctx, code)}
+}
+
+func (_c *MockAPI_EnableCurrency_Call) Run(run func(ctx context.Context, code string)) *MockAPI_EnableCurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_EnableCurrency_Call) Return(_a0 error) *MockAPI_EnableCurrency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_EnableCurrency_Call) RunAndReturn(run func(context.Context, string) error) *MockAPI_EnableCurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProducts provides a mock function with given fields: ctx, filter, fn
+func (_m *MockAPI) ExportProducts(ctx context.Context, filter domain.Filter, fn func([]*domain.Product) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter, func([]*domain.Product) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ExportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProducts'
+type MockAPI_ExportProducts_Call struct {
+	*mock.Call
+}
+
+// ExportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+//   - fn func([]*domain.Product) error
+func (_e *MockAPI_Expecter) ExportProducts(ctx interface{}, filter interface{}, fn interface{}) *MockAPI_ExportProducts_Call {
+	return &MockAPI_ExportProducts_Call{Call: _e.mock.On("ExportProducts", ctx, filter, fn)}
+}
+
+func (_c *MockAPI_ExportProducts_Call) Run(run func(ctx context.Context, filter domain.Filter, fn func([]*domain.Product) error)) *MockAPI_ExportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter), args[2].(func([]*domain.Product) error))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ExportProducts_Call) Return(_a0 error) *MockAPI_ExportProducts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ExportProducts_Call) RunAndReturn(run func(context.Context, domain.Filter, func([]*domain.Product) error) error) *MockAPI_ExportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategories provides a mock function with given fields: ctx
+func (_m *MockAPI) GetCategories(ctx context.Context) ([]*domain.MainCategory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []*domain.MainCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.MainCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.MainCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.MainCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type MockAPI_GetCategories_Call struct {
+	*mock.Call
+}
+
+// GetCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GetCategories(ctx interface{}) *MockAPI_GetCategories_Call {
+	return &MockAPI_GetCategories_Call{Call: _e.mock.On("GetCategories", ctx)}
+}
+
+func (_c *MockAPI_GetCategories_Call) Run(run func(ctx context.Context)) *MockAPI_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetCategories_Call) Return(_a0 []*domain.MainCategory, _a1 error) *MockAPI_GetCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetCategories_Call) RunAndReturn(run func(context.Context) ([]*domain.MainCategory, error)) *MockAPI_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCostLayers provides a mock function with given fields: ctx, productID
+func (_m *MockAPI) GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCostLayers")
+	}
+
+	var r0 []*domain.CostLayer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.CostLayer, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.CostLayer); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CostLayer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetCostLayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCostLayers'
+type MockAPI_GetCostLayers_Call struct {
+	*mock.Call
+}
+
+// GetCostLayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockAPI_Expecter) GetCostLayers(ctx interface{}, productID interface{}) *MockAPI_GetCostLayers_Call {
+	return &MockAPI_GetCostLayers_Call{Call: _e.mock.On("GetCostLayers", ctx, productID)}
+}
+
+func (_c *MockAPI_GetCostLayers_Call) Run(run func(ctx context.Context, productID int64)) *MockAPI_GetCostLayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetCostLayers_Call) Return(_a0 []*domain.CostLayer, _a1 error) *MockAPI_GetCostLayers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetCostLayers_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.CostLayer, error)) *MockAPI_GetCostLayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrencies provides a mock function with given fields: ctx, includeDisabled
+func (_m *MockAPI) GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error) {
+	ret := _m.Called(ctx, includeDisabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrencies")
+	}
+
+	var r0 []*domain.Currency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*domain.Currency, error)); ok {
+		return rf(ctx, includeDisabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*domain.Currency); ok {
+		r0 = rf(ctx, includeDisabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Currency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeDisabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetCurrencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrencies'
+type MockAPI_GetCurrencies_Call struct {
+	*mock.Call
+}
+
+// GetCurrencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeDisabled bool
+func (_e *MockAPI_Expecter) GetCurrencies(ctx interface{}, includeDisabled interface{}) *MockAPI_GetCurrencies_Call {
+	return &MockAPI_GetCurrencies_Call{Call: _e.mock.On("GetCurrencies", ctx, includeDisabled)}
+}
+
+func (_c *MockAPI_GetCurrencies_Call) Run(run func(ctx context.Context, includeDisabled bool)) *MockAPI_GetCurrencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetCurrencies_Call) Return(_a0 []*domain.Currency, _a1 error) *MockAPI_GetCurrencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetCurrencies_Call) RunAndReturn(run func(context.Context, bool) ([]*domain.Currency, error)) *MockAPI_GetCurrencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedProducts provides a mock function with given fields: ctx, filter
+func (_m *MockAPI) GetDeletedProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedProducts")
+	}
+
+	var r0 []*domain.Product
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedProducts'
+type MockAPI_GetDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// GetDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) GetDeletedProducts(ctx interface{}, filter interface{}) *MockAPI_GetDeletedProducts_Call {
+	return &MockAPI_GetDeletedProducts_Call{Call: _e.mock.On("GetDeletedProducts", ctx, filter)}
+}
+
+func (_c *MockAPI_GetDeletedProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockAPI_GetDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetDeletedProducts_Call) Return(_a0 []*domain.Product, _a1 domain.Metadata, _a2 error) *MockAPI_GetDeletedProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_GetDeletedProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)) *MockAPI_GetDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExchangeRate provides a mock function with given fields: ctx, base, quote, at
+func (_m *MockAPI) GetExchangeRate(ctx context.Context, base string, quote string, at time.Time) (*domain.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, quote, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExchangeRate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*domain.ExchangeRate, error)); ok {
+		return rf(ctx, base, quote, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *domain.ExchangeRate); ok {
+		r0 = rf(ctx, base, quote, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, base, quote, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExchangeRate'
+type MockAPI_GetExchangeRate_Call struct {
+	*mock.Call
+}
+
+// GetExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base string
+//   - quote string
+//   - at time.Time
+func (_e *MockAPI_Expecter) GetExchangeRate(ctx interface{}, base interface{}, quote interface{}, at interface{}) *MockAPI_GetExchangeRate_Call {
+	return &MockAPI_GetExchangeRate_Call{Call: _e.mock.On("GetExchangeRate", ctx, base, quote, at)}
+}
+
+func (_c *MockAPI_GetExchangeRate_Call) Run(run func(ctx context.Context, base string, quote string, at time.Time)) *MockAPI_GetExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetExchangeRate_Call) Return(_a0 *domain.ExchangeRate, _a1 error) *MockAPI_GetExchangeRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetExchangeRate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*domain.ExchangeRate, error)) *MockAPI_GetExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInventoryValuation provides a mock function with given fields: ctx
+func (_m *MockAPI) GetInventoryValuation(ctx context.Context) (*domain.ValuationReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryValuation")
+	}
+
+	var r0 *domain.ValuationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.ValuationReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.ValuationReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ValuationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetInventoryValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInventoryValuation'
+type MockAPI_GetInventoryValuation_Call struct {
+	*mock.Call
+}
+
+// GetInventoryValuation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GetInventoryValuation(ctx interface{}) *MockAPI_GetInventoryValuation_Call {
+	return &MockAPI_GetInventoryValuation_Call{Call: _e.mock.On("GetInventoryValuation", ctx)}
+}
+
+func (_c *MockAPI_GetInventoryValuation_Call) Run(run func(ctx context.Context)) *MockAPI_GetInventoryValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetInventoryValuation_Call) Return(_a0 *domain.ValuationReport, _a1 error) *MockAPI_GetInventoryValuation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetInventoryValuation_Call) RunAndReturn(run func(context.Context) (*domain.ValuationReport, error)) *MockAPI_GetInventoryValuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLowStockProducts provides a mock function with given fields: ctx, filter
+func (_m *MockAPI) GetLowStockProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowStockProducts")
+	}
+
+	var r0 []*domain.Product
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLowStockProducts'
+type MockAPI_GetLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// GetLowStockProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) GetLowStockProducts(ctx interface{}, filter interface{}) *MockAPI_GetLowStockProducts_Call {
+	return &MockAPI_GetLowStockProducts_Call{Call: _e.mock.On("GetLowStockProducts", ctx, filter)}
+}
+
+func (_c *MockAPI_GetLowStockProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockAPI_GetLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetLowStockProducts_Call) Return(_a0 []*domain.Product, _a1 domain.Metadata, _a2 error) *MockAPI_GetLowStockProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_GetLowStockProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)) *MockAPI_GetLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductAsOf provides a mock function with given fields: ctx, id, at
+func (_m *MockAPI) GetProductAsOf(ctx context.Context, id int64, at time.Time) (*domain.Product, error) {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductAsOf")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*domain.Product, error)); ok {
+		return rf(ctx, id, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) *domain.Product); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductAsOf'
+type MockAPI_GetProductAsOf_Call struct {
+	*mock.Call
+}
+
+// GetProductAsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - at time.Time
+func (_e *MockAPI_Expecter) GetProductAsOf(ctx interface{}, id interface{}, at interface{}) *MockAPI_GetProductAsOf_Call {
+	return &MockAPI_GetProductAsOf_Call{Call: _e.mock.On("GetProductAsOf", ctx, id, at)}
+}
+
+func (_c *MockAPI_GetProductAsOf_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockAPI_GetProductAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductAsOf_Call) Return(_a0 *domain.Product, _a1 error) *MockAPI_GetProductAsOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductAsOf_Call) RunAndReturn(run func(context.Context, int64, time.Time) (*domain.Product, error)) *MockAPI_GetProductAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByBarcode provides a mock function with given fields: ctx, barcode, opts
+func (_m *MockAPI) GetProductByBarcode(ctx context.Context, barcode string, opts ...domain.ReadOptions) (*domain.Product, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, barcode)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByBarcode")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...domain.ReadOptions) (*domain.Product, error)); ok {
+		return rf(ctx, barcode, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...domain.ReadOptions) *domain.Product); ok {
+		r0 = rf(ctx, barcode, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...domain.ReadOptions) error); ok {
+		r1 = rf(ctx, barcode, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type MockAPI_GetProductByBarcode_Call struct {
+	*mock.Call
+}
+
+// GetProductByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+//   - opts ...domain.ReadOptions
+func (_e *MockAPI_Expecter) GetProductByBarcode(ctx interface{}, barcode interface{}, opts ...interface{}) *MockAPI_GetProductByBarcode_Call {
+	return &MockAPI_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode",
+		append([]interface{}{ctx, barcode}, opts...)...)}
+}
+
+func (_c *MockAPI_GetProductByBarcode_Call) Run(run func(ctx context.Context, barcode string, opts ...domain.ReadOptions)) *MockAPI_GetProductByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]domain.ReadOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(domain.ReadOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductByBarcode_Call) Return(_a0 *domain.Product, _a1 error) *MockAPI_GetProductByBarcode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductByBarcode_Call) RunAndReturn(run func(context.Context, string, ...domain.ReadOptions) (*domain.Product, error)) *MockAPI_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByID provides a mock function with given fields: ctx, id, opts
+func (_m *MockAPI) GetProductByID(ctx context.Context, id int64, opts ...domain.ReadOptions) (*domain.Product, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...domain.ReadOptions) (*domain.Product, error)); ok {
+		return rf(ctx, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...domain.ReadOptions) *domain.Product); ok {
+		r0 = rf(ctx, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...domain.ReadOptions) error); ok {
+		r1 = rf(ctx, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByID'
+type MockAPI_GetProductByID_Call struct {
+	*mock.Call
+}
+
+// GetProductByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - opts ...domain.ReadOptions
+func (_e *MockAPI_Expecter) GetProductByID(ctx interface{}, id interface{}, opts ...interface{}) *MockAPI_GetProductByID_Call {
+	return &MockAPI_GetProductByID_Call{Call: _e.mock.On("GetProductByID",
+		append([]interface{}{ctx, id}, opts...)...)}
+}
+
+func (_c *MockAPI_GetProductByID_Call) Run(run func(ctx context.Context, id int64, opts ...domain.ReadOptions)) *MockAPI_GetProductByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]domain.ReadOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(domain.ReadOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductByID_Call) Return(_a0 *domain.Product, _a1 error) *MockAPI_GetProductByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductByID_Call) RunAndReturn(run func(context.Context, int64, ...domain.ReadOptions) (*domain.Product, error)) *MockAPI_GetProductByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductBySKU provides a mock function with given fields: ctx, sku, opts
+func (_m *MockAPI) GetProductBySKU(ctx context.Context, sku string, opts ...domain.ReadOptions) (*domain.Product, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sku)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductBySKU")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...domain.ReadOptions) (*domain.Product, error)); ok {
+		return rf(ctx, sku, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...domain.ReadOptions) *domain.Product); ok {
+		r0 = rf(ctx, sku, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...domain.ReadOptions) error); ok {
+		r1 = rf(ctx, sku, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBySKU'
+type MockAPI_GetProductBySKU_Call struct {
+	*mock.Call
+}
+
+// GetProductBySKU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+//   - opts ...domain.ReadOptions
+func (_e *MockAPI_Expecter) GetProductBySKU(ctx interface{}, sku interface{}, opts ...interface{}) *MockAPI_GetProductBySKU_Call {
+	return &MockAPI_GetProductBySKU_Call{Call: _e.mock.On("GetProductBySKU",
+		append([]interface{}{ctx, sku}, opts...)...)}
+}
+
+func (_c *MockAPI_GetProductBySKU_Call) Run(run func(ctx context.Context, sku string, opts ...domain.ReadOptions)) *MockAPI_GetProductBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]domain.ReadOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(domain.ReadOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductBySKU_Call) Return(_a0 *domain.Product, _a1 error) *MockAPI_GetProductBySKU_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductBySKU_Call) RunAndReturn(run func(context.Context, string, ...domain.ReadOptions) (*domain.Product, error)) *MockAPI_GetProductBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductHistory provides a mock function with given fields: ctx, productID
+func (_m *MockAPI) GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductHistory")
+	}
+
+	var r0 []*domain.ProductSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.ProductSnapshot, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.ProductSnapshot); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductHistory'
+type MockAPI_GetProductHistory_Call struct {
+	*mock.Call
+}
+
+// GetProductHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockAPI_Expecter) GetProductHistory(ctx interface{}, productID interface{}) *MockAPI_GetProductHistory_Call {
+	return &MockAPI_GetProductHistory_Call{Call: _e.mock.On("GetProductHistory", ctx, productID)}
+}
+
+func (_c *MockAPI_GetProductHistory_Call) Run(run func(ctx context.Context, productID int64)) *MockAPI_GetProductHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductHistory_Call) Return(_a0 []*domain.ProductSnapshot, _a1 error) *MockAPI_GetProductHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductHistory_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.ProductSnapshot, error)) *MockAPI_GetProductHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductStock provides a mock function with given fields: ctx, productID
+func (_m *MockAPI) GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductStock")
+	}
+
+	var r0 *domain.ProductStock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.ProductStock, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.ProductStock); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductStock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductStock'
+type MockAPI_GetProductStock_Call struct {
+	*mock.Call
+}
+
+// GetProductStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockAPI_Expecter) GetProductStock(ctx interface{}, productID interface{}) *MockAPI_GetProductStock_Call {
+	return &MockAPI_GetProductStock_Call{Call: _e.mock.On("GetProductStock", ctx, productID)}
+}
+
+func (_c *MockAPI_GetProductStock_Call) Run(run func(ctx context.Context, productID int64)) *MockAPI_GetProductStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductStock_Call) Return(_a0 *domain.ProductStock, _a1 error) *MockAPI_GetProductStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductStock_Call) RunAndReturn(run func(context.Context, int64) (*domain.ProductStock, error)) *MockAPI_GetProductStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductSuppliers provides a mock function with given fields: ctx, productID
+func (_m *MockAPI) GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductSuppliers")
+	}
+
+	var r0 []*domain.ProductSupplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.ProductSupplier, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.ProductSupplier); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductSupplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetProductSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductSuppliers'
+type MockAPI_GetProductSuppliers_Call struct {
+	*mock.Call
+}
+
+// GetProductSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockAPI_Expecter) GetProductSuppliers(ctx interface{}, productID interface{}) *MockAPI_GetProductSuppliers_Call {
+	return &MockAPI_GetProductSuppliers_Call{Call: _e.mock.On("GetProductSuppliers", ctx, productID)}
+}
+
+func (_c *MockAPI_GetProductSuppliers_Call) Run(run func(ctx context.Context, productID int64)) *MockAPI_GetProductSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductSuppliers_Call) Return(_a0 []*domain.ProductSupplier, _a1 error) *MockAPI_GetProductSuppliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetProductSuppliers_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.ProductSupplier, error)) *MockAPI_GetProductSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProducts provides a mock function with given fields: ctx, filter
+func (_m *MockAPI) GetProducts(ctx context.Context, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProducts")
+	}
+
+	var r0 []*domain.Product
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
+type MockAPI_GetProducts_Call struct {
+	*mock.Call
+}
+
+// GetProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) GetProducts(ctx interface{}, filter interface{}) *MockAPI_GetProducts_Call {
+	return &MockAPI_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, filter)}
+}
+
+func (_c *MockAPI_GetProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockAPI_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProducts_Call) Return(_a0 []*domain.Product, _a1 domain.Metadata, _a2 error) *MockAPI_GetProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_GetProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) ([]*domain.Product, domain.Metadata, error)) *MockAPI_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductsByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockAPI) GetProductsByIDs(ctx context.Context, ids []int64) ([]*domain.Product, []int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsByIDs")
+	}
+
+	var r0 []*domain.Product
+	var r1 []int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*domain.Product, []int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*domain.Product); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) []int64); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []int64) error); ok {
+		r2 = rf(ctx, ids)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetProductsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductsByIDs'
+type MockAPI_GetProductsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetProductsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockAPI_Expecter) GetProductsByIDs(ctx interface{}, ids interface{}) *MockAPI_GetProductsByIDs_Call {
+	return &MockAPI_GetProductsByIDs_Call{Call: _e.mock.On("GetProductsByIDs", ctx, ids)}
+}
+
+func (_c *MockAPI_GetProductsByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockAPI_GetProductsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetProductsByIDs_Call) Return(products []*domain.Product, missingIDs []int64, err error) *MockAPI_GetProductsByIDs_Call {
+	_c.Call.Return(products, missingIDs, err)
+	return _c
+}
+
+func (_c *MockAPI_GetProductsByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]*domain.Product, []int64, error)) *MockAPI_GetProductsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *MockAPI) GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurchaseOrder'
+type MockAPI_GetPurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// GetPurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) GetPurchaseOrder(ctx interface{}, id interface{}) *MockAPI_GetPurchaseOrder_Call {
+	return &MockAPI_GetPurchaseOrder_Call{Call: _e.mock.On("GetPurchaseOrder", ctx, id)}
+}
+
+func (_c *MockAPI_GetPurchaseOrder_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_GetPurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetPurchaseOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *MockAPI_GetPurchaseOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetPurchaseOrder_Call) RunAndReturn(run func(context.Context, int64) (*domain.PurchaseOrder, error)) *MockAPI_GetPurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReservations provides a mock function with given fields: ctx, orderID
+func (_m *MockAPI) GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReservations")
+	}
+
+	var r0 []*domain.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Reservation, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.Reservation); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReservations'
+type MockAPI_GetReservations_Call struct {
+	*mock.Call
+}
+
+// GetReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+func (_e *MockAPI_Expecter) GetReservations(ctx interface{}, orderID interface{}) *MockAPI_GetReservations_Call {
+	return &MockAPI_GetReservations_Call{Call: _e.mock.On("GetReservations", ctx, orderID)}
+}
+
+func (_c *MockAPI_GetReservations_Call) Run(run func(ctx context.Context, orderID string)) *MockAPI_GetReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetReservations_Call) Return(_a0 []*domain.Reservation, _a1 error) *MockAPI_GetReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetReservations_Call) RunAndReturn(run func(context.Context, string) ([]*domain.Reservation, error)) *MockAPI_GetReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockDiscrepancies provides a mock function with given fields: ctx, filter
+func (_m *MockAPI) GetStockDiscrepancies(ctx context.Context, filter domain.Filter) ([]*domain.ProductStock, domain.Metadata, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockDiscrepancies")
+	}
+
+	var r0 []*domain.ProductStock
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) ([]*domain.ProductStock, domain.Metadata, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []*domain.ProductStock); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductStock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetStockDiscrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockDiscrepancies'
+type MockAPI_GetStockDiscrepancies_Call struct {
+	*mock.Call
+}
+
+// GetStockDiscrepancies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) GetStockDiscrepancies(ctx interface{}, filter interface{}) *MockAPI_GetStockDiscrepancies_Call {
+	return &MockAPI_GetStockDiscrepancies_Call{Call: _e.mock.On("GetStockDiscrepancies", ctx, filter)}
+}
+
+func (_c *MockAPI_GetStockDiscrepancies_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockAPI_GetStockDiscrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetStockDiscrepancies_Call) Return(_a0 []*domain.ProductStock, _a1 domain.Metadata, _a2 error) *MockAPI_GetStockDiscrepancies_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_GetStockDiscrepancies_Call) RunAndReturn(run func(context.Context, domain.Filter) ([]*domain.ProductStock, domain.Metadata, error)) *MockAPI_GetStockDiscrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockMovements provides a mock function with given fields: ctx, productID, filter
+func (_m *MockAPI) GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) ([]*domain.StockMovement, domain.Metadata, error) {
+	ret := _m.Called(ctx, productID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockMovements")
+	}
+
+	var r0 []*domain.StockMovement
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Filter) ([]*domain.StockMovement, domain.Metadata, error)); ok {
+		return rf(ctx, productID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Filter) []*domain.StockMovement); ok {
+		r0 = rf(ctx, productID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, productID, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, domain.Filter) error); ok {
+		r2 = rf(ctx, productID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_GetStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockMovements'
+type MockAPI_GetStockMovements_Call struct {
+	*mock.Call
+}
+
+// GetStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) GetStockMovements(ctx interface{}, productID interface{}, filter interface{}) *MockAPI_GetStockMovements_Call {
+	return &MockAPI_GetStockMovements_Call{Call: _e.mock.On("GetStockMovements", ctx, productID, filter)}
+}
+
+func (_c *MockAPI_GetStockMovements_Call) Run(run func(ctx context.Context, productID int64, filter domain.Filter)) *MockAPI_GetStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetStockMovements_Call) Return(_a0 []*domain.StockMovement, _a1 domain.Metadata, _a2 error) *MockAPI_GetStockMovements_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_GetStockMovements_Call) RunAndReturn(run func(context.Context, int64, domain.Filter) ([]*domain.StockMovement, domain.Metadata, error)) *MockAPI_GetStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockAPI) GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockTransfer")
+	}
+
+	var r0 *domain.StockTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.StockTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.StockTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StockTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockTransfer'
+type MockAPI_GetStockTransfer_Call struct {
+	*mock.Call
+}
+
+// GetStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) GetStockTransfer(ctx interface{}, id interface{}) *MockAPI_GetStockTransfer_Call {
+	return &MockAPI_GetStockTransfer_Call{Call: _e.mock.On("GetStockTransfer", ctx, id)}
+}
+
+func (_c *MockAPI_GetStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_GetStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetStockTransfer_Call) Return(_a0 *domain.StockTransfer, _a1 error) *MockAPI_GetStockTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetStockTransfer_Call) RunAndReturn(run func(context.Context, int64) (*domain.StockTransfer, error)) *MockAPI_GetStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSupplier provides a mock function with given fields: ctx, id
+func (_m *MockAPI) GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSupplier")
+	}
+
+	var r0 *domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Supplier, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Supplier); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSupplier'
+type MockAPI_GetSupplier_Call struct {
+	*mock.Call
+}
+
+// GetSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) GetSupplier(ctx interface{}, id interface{}) *MockAPI_GetSupplier_Call {
+	return &MockAPI_GetSupplier_Call{Call: _e.mock.On("GetSupplier", ctx, id)}
+}
+
+func (_c *MockAPI_GetSupplier_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_GetSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetSupplier_Call) Return(_a0 *domain.Supplier, _a1 error) *MockAPI_GetSupplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetSupplier_Call) RunAndReturn(run func(context.Context, int64) (*domain.Supplier, error)) *MockAPI_GetSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuppliers provides a mock function with given fields: ctx
+func (_m *MockAPI) GetSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuppliers")
+	}
+
+	var r0 []*domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Supplier, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Supplier); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuppliers'
+type MockAPI_GetSuppliers_Call struct {
+	*mock.Call
+}
+
+// GetSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GetSuppliers(ctx interface{}) *MockAPI_GetSuppliers_Call {
+	return &MockAPI_GetSuppliers_Call{Call: _e.mock.On("GetSuppliers", ctx)}
+}
+
+func (_c *MockAPI_GetSuppliers_Call) Run(run func(ctx context.Context)) *MockAPI_GetSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetSuppliers_Call) Return(_a0 []*domain.Supplier, _a1 error) *MockAPI_GetSuppliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetSuppliers_Call) RunAndReturn(run func(context.Context) ([]*domain.Supplier, error)) *MockAPI_GetSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVariants provides a mock function with given fields: ctx, productID
+func (_m *MockAPI) GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariants")
+	}
+
+	var r0 []domain.Variant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Variant, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Variant); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Variant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetVariants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVariants'
+type MockAPI_GetVariants_Call struct {
+	*mock.Call
+}
+
+// GetVariants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockAPI_Expecter) GetVariants(ctx interface{}, productID interface{}) *MockAPI_GetVariants_Call {
+	return &MockAPI_GetVariants_Call{Call: _e.mock.On("GetVariants", ctx, productID)}
+}
+
+func (_c *MockAPI_GetVariants_Call) Run(run func(ctx context.Context, productID int64)) *MockAPI_GetVariants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetVariants_Call) Return(_a0 []domain.Variant, _a1 error) *MockAPI_GetVariants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetVariants_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Variant, error)) *MockAPI_GetVariants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWarehouses provides a mock function with given fields: ctx
+func (_m *MockAPI) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWarehouses")
+	}
+
+	var r0 []*domain.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GetWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouses'
+type MockAPI_GetWarehouses_Call struct {
+	*mock.Call
+}
+
+// GetWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GetWarehouses(ctx interface{}) *MockAPI_GetWarehouses_Call {
+	return &MockAPI_GetWarehouses_Call{Call: _e.mock.On("GetWarehouses", ctx)}
+}
+
+func (_c *MockAPI_GetWarehouses_Call) Run(run func(ctx context.Context)) *MockAPI_GetWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GetWarehouses_Call) Return(_a0 []*domain.Warehouse, _a1 error) *MockAPI_GetWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GetWarehouses_Call) RunAndReturn(run func(context.Context) ([]*domain.Warehouse, error)) *MockAPI_GetWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportProducts provides a mock function with given fields: ctx, reqs
+func (_m *MockAPI) ImportProducts(ctx context.Context, reqs []*domain.CreateProductRequest) (*domain.ImportReport, error) {
+	ret := _m.Called(ctx, reqs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportProducts")
+	}
+
+	var r0 *domain.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.CreateProductRequest) (*domain.ImportReport, error)); ok {
+		return rf(ctx, reqs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.CreateProductRequest) *domain.ImportReport); ok {
+		r0 = rf(ctx, reqs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.CreateProductRequest) error); ok {
+		r1 = rf(ctx, reqs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ImportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportProducts'
+type MockAPI_ImportProducts_Call struct {
+	*mock.Call
+}
+
+// ImportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reqs []*domain.CreateProductRequest
+func (_e *MockAPI_Expecter) ImportProducts(ctx interface{}, reqs interface{}) *MockAPI_ImportProducts_Call {
+	return &MockAPI_ImportProducts_Call{Call: _e.mock.On("ImportProducts", ctx, reqs)}
+}
+
+func (_c *MockAPI_ImportProducts_Call) Run(run func(ctx context.Context, reqs []*domain.CreateProductRequest)) *MockAPI_ImportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*domain.CreateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ImportProducts_Call) Return(_a0 *domain.ImportReport, _a1 error) *MockAPI_ImportProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ImportProducts_Call) RunAndReturn(run func(context.Context, []*domain.CreateProductRequest) (*domain.ImportReport, error)) *MockAPI_ImportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedProducts provides a mock function with given fields: ctx, retention
+func (_m *MockAPI) PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedProducts")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_PurgeDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedProducts'
+type MockAPI_PurgeDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *MockAPI_Expecter) PurgeDeletedProducts(ctx interface{}, retention interface{}) *MockAPI_PurgeDeletedProducts_Call {
+	return &MockAPI_PurgeDeletedProducts_Call{Call: _e.mock.On("PurgeDeletedProducts", ctx, retention)}
+}
+
+func (_c *MockAPI_PurgeDeletedProducts_Call) Run(run func(ctx context.Context, retention time.Duration)) *MockAPI_PurgeDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockAPI_PurgeDeletedProducts_Call) Return(_a0 int64, _a1 error) *MockAPI_PurgeDeletedProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_PurgeDeletedProducts_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, error)) *MockAPI_PurgeDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeOutboxEvents provides a mock function with given fields: ctx, retention
+func (_m *MockAPI) PurgeOutboxEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeOutboxEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_PurgeOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeOutboxEvents'
+type MockAPI_PurgeOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// PurgeOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *MockAPI_Expecter) PurgeOutboxEvents(ctx interface{}, retention interface{}) *MockAPI_PurgeOutboxEvents_Call {
+	return &MockAPI_PurgeOutboxEvents_Call{Call: _e.mock.On("PurgeOutboxEvents", ctx, retention)}
+}
+
+func (_c *MockAPI_PurgeOutboxEvents_Call) Run(run func(ctx context.Context, retention time.Duration)) *MockAPI_PurgeOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockAPI_PurgeOutboxEvents_Call) Return(_a0 int64, _a1 error) *MockAPI_PurgeOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_PurgeOutboxEvents_Call) RunAndReturn(run func(context.Context, time.Duration) (int64, error)) *MockAPI_PurgeOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveGoods provides a mock function with given fields: ctx, req
+func (_m *MockAPI) ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveGoods")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReceiveGoodsRequest) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReceiveGoodsRequest) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ReceiveGoodsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReceiveGoods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveGoods'
+type MockAPI_ReceiveGoods_Call struct {
+	*mock.Call
+}
+
+// ReceiveGoods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ReceiveGoodsRequest
+func (_e *MockAPI_Expecter) ReceiveGoods(ctx interface{}, req interface{}) *MockAPI_ReceiveGoods_Call {
+	return &MockAPI_ReceiveGoods_Call{Call: _e.mock.On("ReceiveGoods", ctx, req)}
+}
+
+func (_c *MockAPI_ReceiveGoods_Call) Run(run func(ctx context.Context, req *domain.ReceiveGoodsRequest)) *MockAPI_ReceiveGoods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReceiveGoodsRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReceiveGoods_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *MockAPI_ReceiveGoods_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReceiveGoods_Call) RunAndReturn(run func(context.Context, *domain.ReceiveGoodsRequest) (*domain.PurchaseOrder, error)) *MockAPI_ReceiveGoods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockAPI) ReceiveStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveStockTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ReceiveStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveStockTransfer'
+type MockAPI_ReceiveStockTransfer_Call struct {
+	*mock.Call
+}
+
+// ReceiveStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) ReceiveStockTransfer(ctx interface{}, id interface{}) *MockAPI_ReceiveStockTransfer_Call {
+	return &MockAPI_ReceiveStockTransfer_Call{Call: _e.mock.On("ReceiveStockTransfer", ctx, id)}
+}
+
+func (_c *MockAPI_ReceiveStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_ReceiveStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReceiveStockTransfer_Call) Return(_a0 error) *MockAPI_ReceiveStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ReceiveStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_ReceiveStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RelayEvents provides a mock function with given fields: ctx
+func (_m *MockAPI) RelayEvents(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RelayEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RelayEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelayEvents'
+type MockAPI_RelayEvents_Call struct {
+	*mock.Call
+}
+
+// RelayEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) RelayEvents(ctx interface{}) *MockAPI_RelayEvents_Call {
+	return &MockAPI_RelayEvents_Call{Call: _e.mock.On("RelayEvents", ctx)}
+}
+
+func (_c *MockAPI_RelayEvents_Call) Run(run func(ctx context.Context)) *MockAPI_RelayEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RelayEvents_Call) Return(_a0 int64, _a1 error) *MockAPI_RelayEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RelayEvents_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockAPI_RelayEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseExpiredReservations provides a mock function with given fields: ctx
+func (_m *MockAPI) ReleaseExpiredReservations(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseExpiredReservations")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReleaseExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiredReservations'
+type MockAPI_ReleaseExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ReleaseExpiredReservations(ctx interface{}) *MockAPI_ReleaseExpiredReservations_Call {
+	return &MockAPI_ReleaseExpiredReservations_Call{Call: _e.mock.On("ReleaseExpiredReservations", ctx)}
+}
+
+func (_c *MockAPI_ReleaseExpiredReservations_Call) Run(run func(ctx context.Context)) *MockAPI_ReleaseExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReleaseExpiredReservations_Call) Return(_a0 int64, _a1 error) *MockAPI_ReleaseExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReleaseExpiredReservations_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockAPI_ReleaseExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderID
+func (_m *MockAPI) ReleaseReservation(ctx context.Context, orderID string) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type MockAPI_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+func (_e *MockAPI_Expecter) ReleaseReservation(ctx interface{}, orderID interface{}) *MockAPI_ReleaseReservation_Call {
+	return &MockAPI_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderID)}
+}
+
+func (_c *MockAPI_ReleaseReservation_Call) Run(run func(ctx context.Context, orderID string)) *MockAPI_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReleaseReservation_Call) Return(_a0 error) *MockAPI_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *MockAPI_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProductSupplier provides a mock function with given fields: ctx, req
+func (_m *MockAPI) RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProductSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RemoveProductSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RemoveProductSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProductSupplier'
+type MockAPI_RemoveProductSupplier_Call struct {
+	*mock.Call
+}
+
+// RemoveProductSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.RemoveProductSupplierRequest
+func (_e *MockAPI_Expecter) RemoveProductSupplier(ctx interface{}, req interface{}) *MockAPI_RemoveProductSupplier_Call {
+	return &MockAPI_RemoveProductSupplier_Call{Call: _e.mock.On("RemoveProductSupplier", ctx, req)}
+}
+
+func (_c *MockAPI_RemoveProductSupplier_Call) Run(run func(ctx context.Context, req *domain.RemoveProductSupplierRequest)) *MockAPI_RemoveProductSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RemoveProductSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RemoveProductSupplier_Call) Return(_a0 error) *MockAPI_RemoveProductSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RemoveProductSupplier_Call) RunAndReturn(run func(context.Context, *domain.RemoveProductSupplierRequest) error) *MockAPI_RemoveProductSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, req
+func (_m *MockAPI) ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (*domain.Reservation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 *domain.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReserveStockRequest) (*domain.Reservation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReserveStockRequest) *domain.Reservation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ReserveStockRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockAPI_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ReserveStockRequest
+func (_e *MockAPI_Expecter) ReserveStock(ctx interface{}, req interface{}) *MockAPI_ReserveStock_Call {
+	return &MockAPI_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, req)}
+}
+
+func (_c *MockAPI_ReserveStock_Call) Run(run func(ctx context.Context, req *domain.ReserveStockRequest)) *MockAPI_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReserveStockRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReserveStock_Call) Return(_a0 *domain.Reservation, _a1 error) *MockAPI_ReserveStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReserveStock_Call) RunAndReturn(run func(context.Context, *domain.ReserveStockRequest) (*domain.Reservation, error)) *MockAPI_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function with given fields: ctx, id
+func (_m *MockAPI) RestoreProduct(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockAPI_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAPI_Expecter) RestoreProduct(ctx interface{}, id interface{}) *MockAPI_RestoreProduct_Call {
+	return &MockAPI_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, id)}
+}
+
+func (_c *MockAPI_RestoreProduct_Call) Run(run func(ctx context.Context, id int64)) *MockAPI_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RestoreProduct_Call) Return(_a0 error) *MockAPI_RestoreProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RestoreProduct_Call) RunAndReturn(run func(context.Context, int64) error) *MockAPI_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunProductChangeFeed provides a mock function with given fields: ctx
+func (_m *MockAPI) RunProductChangeFeed(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunProductChangeFeed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RunProductChangeFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunProductChangeFeed'
+type MockAPI_RunProductChangeFeed_Call struct {
+	*mock.Call
+}
+
+// RunProductChangeFeed is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) RunProductChangeFeed(ctx interface{}) *MockAPI_RunProductChangeFeed_Call {
+	return &MockAPI_RunProductChangeFeed_Call{Call: _e.mock.On("RunProductChangeFeed", ctx)}
+}
+
+func (_c *MockAPI_RunProductChangeFeed_Call) Run(run func(ctx context.Context)) *MockAPI_RunProductChangeFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RunProductChangeFeed_Call) Return(_a0 error) *MockAPI_RunProductChangeFeed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RunProductChangeFeed_Call) RunAndReturn(run func(context.Context) error) *MockAPI_RunProductChangeFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchProducts provides a mock function with given fields: ctx, query, filter
+func (_m *MockAPI) SearchProducts(ctx context.Context, query string, filter domain.Filter) ([]*domain.Product, domain.Metadata, error) {
+	ret := _m.Called(ctx, query, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchProducts")
+	}
+
+	var r0 []*domain.Product
+	var r1 domain.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Filter) ([]*domain.Product, domain.Metadata, error)); ok {
+		return rf(ctx, query, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Filter) []*domain.Product); ok {
+		r0 = rf(ctx, query, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.Filter) domain.Metadata); ok {
+		r1 = rf(ctx, query, filter)
+	} else {
+		r1 = ret.Get(1).(domain.Metadata)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.Filter) error); ok {
+		r2 = rf(ctx, query, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPI_SearchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchProducts'
+type MockAPI_SearchProducts_Call struct {
+	*mock.Call
+}
+
+// SearchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - filter domain.Filter
+func (_e *MockAPI_Expecter) SearchProducts(ctx interface{}, query interface{}, filter interface{}) *MockAPI_SearchProducts_Call {
+	return &MockAPI_SearchProducts_Call{Call: _e.mock.On("SearchProducts", ctx, query, filter)}
+}
+
+func (_c *MockAPI_SearchProducts_Call) Run(run func(ctx context.Context, query string, filter domain.Filter)) *MockAPI_SearchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SearchProducts_Call) Return(_a0 []*domain.Product, _a1 domain.Metadata, _a2 error) *MockAPI_SearchProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPI_SearchProducts_Call) RunAndReturn(run func(context.Context, string, domain.Filter) ([]*domain.Product, domain.Metadata, error)) *MockAPI_SearchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetExchangeRate provides a mock function with given fields: ctx, req
+func (_m *MockAPI) SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetExchangeRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SetExchangeRateRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_SetExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExchangeRate'
+type MockAPI_SetExchangeRate_Call struct {
+	*mock.Call
+}
+
+// SetExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.SetExchangeRateRequest
+func (_e *MockAPI_Expecter) SetExchangeRate(ctx interface{}, req interface{}) *MockAPI_SetExchangeRate_Call {
+	return &MockAPI_SetExchangeRate_Call{Call: _e.mock.On("SetExchangeRate", ctx, req)}
+}
+
+func (_c *MockAPI_SetExchangeRate_Call) Run(run func(ctx context.Context, req *domain.SetExchangeRateRequest)) *MockAPI_SetExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SetExchangeRateRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SetExchangeRate_Call) Return(_a0 error) *MockAPI_SetExchangeRate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_SetExchangeRate_Call) RunAndReturn(run func(context.Context, *domain.SetExchangeRateRequest) error) *MockAPI_SetExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProductSupplier provides a mock function with given fields: ctx, req
+func (_m *MockAPI) SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProductSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SetProductSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_SetProductSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProductSupplier'
+type MockAPI_SetProductSupplier_Call struct {
+	*mock.Call
+}
+
+// SetProductSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.SetProductSupplierRequest
+func (_e *MockAPI_Expecter) SetProductSupplier(ctx interface{}, req interface{}) *MockAPI_SetProductSupplier_Call {
+	return &MockAPI_SetProductSupplier_Call{Call: _e.mock.On("SetProductSupplier", ctx, req)}
+}
+
+func (_c *MockAPI_SetProductSupplier_Call) Run(run func(ctx context.Context, req *domain.SetProductSupplierRequest)) *MockAPI_SetProductSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SetProductSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SetProductSupplier_Call) Return(_a0 error) *MockAPI_SetProductSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_SetProductSupplier_Call) RunAndReturn(run func(context.Context, *domain.SetProductSupplierRequest) error) *MockAPI_SetProductSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMainCategory provides a mock function with given fields: ctx, req
+func (_m *MockAPI) UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMainCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateMainCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UpdateMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMainCategory'
+type MockAPI_UpdateMainCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateMainCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateMainCategoryRequest
+func (_e *MockAPI_Expecter) UpdateMainCategory(ctx interface{}, req interface{}) *MockAPI_UpdateMainCategory_Call {
+	return &MockAPI_UpdateMainCategory_Call{Call: _e.mock.On("UpdateMainCategory", ctx, req)}
+}
+
+func (_c *MockAPI_UpdateMainCategory_Call) Run(run func(ctx context.Context, req *domain.UpdateMainCategoryRequest)) *MockAPI_UpdateMainCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateMainCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UpdateMainCategory_Call) Return(_a0 error) *MockAPI_UpdateMainCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UpdateMainCategory_Call) RunAndReturn(run func(context.Context, *domain.UpdateMainCategoryRequest) error) *MockAPI_UpdateMainCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function with given fields: ctx, req
+func (_m *MockAPI) UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type MockAPI_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateProductRequest
+func (_e *MockAPI_Expecter) UpdateProduct(ctx interface{}, req interface{}) *MockAPI_UpdateProduct_Call {
+	return &MockAPI_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, req)}
+}
+
+func (_c *MockAPI_UpdateProduct_Call) Run(run func(ctx context.Context, req *domain.UpdateProductRequest)) *MockAPI_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UpdateProduct_Call) Return(_a0 error) *MockAPI_UpdateProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UpdateProduct_Call) RunAndReturn(run func(context.Context, *domain.UpdateProductRequest) error) *MockAPI_UpdateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockAPI) UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateSubCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UpdateSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubCategory'
+type MockAPI_UpdateSubCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateSubCategoryRequest
+func (_e *MockAPI_Expecter) UpdateSubCategory(ctx interface{}, req interface{}) *MockAPI_UpdateSubCategory_Call {
+	return &MockAPI_UpdateSubCategory_Call{Call: _e.mock.On("UpdateSubCategory", ctx, req)}
+}
+
+func (_c *MockAPI_UpdateSubCategory_Call) Run(run func(ctx context.Context, req *domain.UpdateSubCategoryRequest)) *MockAPI_UpdateSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UpdateSubCategory_Call) Return(_a0 error) *MockAPI_UpdateSubCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UpdateSubCategory_Call) RunAndReturn(run func(context.Context, *domain.UpdateSubCategoryRequest) error) *MockAPI_UpdateSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSupplier provides a mock function with given fields: ctx, req
+func (_m *MockAPI) UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UpdateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSupplier'
+type MockAPI_UpdateSupplier_Call struct {
+	*mock.Call
+}
+
+// UpdateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateSupplierRequest
+func (_e *MockAPI_Expecter) UpdateSupplier(ctx interface{}, req interface{}) *MockAPI_UpdateSupplier_Call {
+	return &MockAPI_UpdateSupplier_Call{Call: _e.mock.On("UpdateSupplier", ctx, req)}
+}
+
+func (_c *MockAPI_UpdateSupplier_Call) Run(run func(ctx context.Context, req *domain.UpdateSupplierRequest)) *MockAPI_UpdateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UpdateSupplier_Call) Return(_a0 error) *MockAPI_UpdateSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UpdateSupplier_Call) RunAndReturn(run func(context.Context, *domain.UpdateSupplierRequest) error) *MockAPI_UpdateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateVariant provides a mock function with given fields: ctx, req
+func (_m *MockAPI) UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateVariantRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UpdateVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVariant'
+type MockAPI_UpdateVariant_Call struct {
+	*mock.Call
+}
+
+// UpdateVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateVariantRequest
+func (_e *MockAPI_Expecter) UpdateVariant(ctx interface{}, req interface{}) *MockAPI_UpdateVariant_Call {
+	return &MockAPI_UpdateVariant_Call{Call: _e.mock.On("UpdateVariant", ctx, req)}
+}
+
+func (_c *MockAPI_UpdateVariant_Call) Run(run func(ctx context.Context, req *domain.UpdateVariantRequest)) *MockAPI_UpdateVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UpdateVariant_Call) Return(_a0 error) *MockAPI_UpdateVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UpdateVariant_Call) RunAndReturn(run func(context.Context, *domain.UpdateVariantRequest) error) *MockAPI_UpdateVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchProducts provides a mock function with given fields: ctx, req, fn
+func (_m *MockAPI) WatchProducts(ctx context.Context, req *domain.WatchProductsRequest, fn func(*domain.ProductChangeEvent) error) error {
+	ret := _m.Called(ctx, req, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.WatchProductsRequest, func(*domain.ProductChangeEvent) error) error); ok {
+		r0 = rf(ctx, req, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_WatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchProducts'
+type MockAPI_WatchProducts_Call struct {
+	*mock.Call
+}
+
+// WatchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.WatchProductsRequest
+//   - fn func(*domain.ProductChangeEvent) error
+func (_e *MockAPI_Expecter) WatchProducts(ctx interface{}, req interface{}, fn interface{}) *MockAPI_WatchProducts_Call {
+	return &MockAPI_WatchProducts_Call{Call: _e.mock.On("WatchProducts", ctx, req, fn)}
+}
+
+func (_c *MockAPI_WatchProducts_Call) Run(run func(ctx context.Context, req *domain.WatchProductsRequest, fn func(*domain.ProductChangeEvent) error)) *MockAPI_WatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.WatchProductsRequest), args[2].(func(*domain.ProductChangeEvent) error))
+	})
+	return _c
+}
+
+func (_c *MockAPI_WatchProducts_Call) Return(_a0 error) *MockAPI_WatchProducts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_WatchProducts_Call) RunAndReturn(run func(context.Context, *domain.WatchProductsRequest, func(*domain.ProductChangeEvent) error) error) *MockAPI_WatchProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
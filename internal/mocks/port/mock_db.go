@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/ebisaan/inventory/internal/application/core/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockDB is an autogenerated mock type for the DB type
@@ -22,26 +24,28 @@ func (_m *MockDB) EXPECT() *MockDB_Expecter {
 	return &MockDB_Expecter{mock: &_m.Mock}
 }
 
-// CreateProduct provides a mock function with given fields: ctx, req
-func (_m *MockDB) CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (int64, error) {
+// AdjustStock provides a mock function with given fields: ctx, req
+func (_m *MockDB) AdjustStock(ctx context.Context, req *domain.AdjustStockRequest) (*domain.StockLevel, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProduct")
+		panic("no return value specified for AdjustStock")
 	}
 
-	var r0 int64
+	var r0 *domain.StockLevel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustStockRequest) (*domain.StockLevel, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustStockRequest) *domain.StockLevel); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StockLevel)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateProductRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.AdjustStockRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -50,228 +54,256 @@ func (_m *MockDB) CreateProduct(ctx context.Context, req *domain.CreateProductRe
 	return r0, r1
 }
 
-// MockDB_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
-type MockDB_CreateProduct_Call struct {
+// MockDB_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type MockDB_AdjustStock_Call struct {
 	*mock.Call
 }
 
-// CreateProduct is a helper method to define mock.On call
+// AdjustStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.CreateProductRequest
-func (_e *MockDB_Expecter) CreateProduct(ctx interface{}, req interface{}) *MockDB_CreateProduct_Call {
-	return &MockDB_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, req)}
+//   - req *domain.AdjustStockRequest
+func (_e *MockDB_Expecter) AdjustStock(ctx interface{}, req interface{}) *MockDB_AdjustStock_Call {
+	return &MockDB_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, req)}
 }
 
-func (_c *MockDB_CreateProduct_Call) Run(run func(ctx context.Context, req *domain.CreateProductRequest)) *MockDB_CreateProduct_Call {
+func (_c *MockDB_AdjustStock_Call) Run(run func(ctx context.Context, req *domain.AdjustStockRequest)) *MockDB_AdjustStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.CreateProductRequest))
+		run(args[0].(context.Context), args[1].(*domain.AdjustStockRequest))
 	})
 	return _c
 }
 
-func (_c *MockDB_CreateProduct_Call) Return(id int64, err error) *MockDB_CreateProduct_Call {
-	_c.Call.Return(id, err)
+func (_c *MockDB_AdjustStock_Call) Return(_a0 *domain.StockLevel, _a1 error) *MockDB_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_CreateProduct_Call) RunAndReturn(run func(context.Context, *domain.CreateProductRequest) (int64, error)) *MockDB_CreateProduct_Call {
+func (_c *MockDB_AdjustStock_Call) RunAndReturn(run func(context.Context, *domain.AdjustStockRequest) (*domain.StockLevel, error)) *MockDB_AdjustStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, req
-func (_m *MockDB) DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error {
+// AdjustWarehouseStock provides a mock function with given fields: ctx, req
+func (_m *MockDB) AdjustWarehouseStock(ctx context.Context, req *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteProduct")
+		panic("no return value specified for AdjustWarehouseStock")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteProductRequest) error); ok {
+	var r0 *domain.WarehouseStock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AdjustWarehouseStockRequest) *domain.WarehouseStock); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WarehouseStock)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.AdjustWarehouseStockRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDB_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
-type MockDB_DeleteProduct_Call struct {
+// MockDB_AdjustWarehouseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustWarehouseStock'
+type MockDB_AdjustWarehouseStock_Call struct {
 	*mock.Call
 }
 
-// DeleteProduct is a helper method to define mock.On call
+// AdjustWarehouseStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.DeleteProductRequest
-func (_e *MockDB_Expecter) DeleteProduct(ctx interface{}, req interface{}) *MockDB_DeleteProduct_Call {
-	return &MockDB_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, req)}
+//   - req *domain.AdjustWarehouseStockRequest
+func (_e *MockDB_Expecter) AdjustWarehouseStock(ctx interface{}, req interface{}) *MockDB_AdjustWarehouseStock_Call {
+	return &MockDB_AdjustWarehouseStock_Call{Call: _e.mock.On("AdjustWarehouseStock", ctx, req)}
 }
 
-func (_c *MockDB_DeleteProduct_Call) Run(run func(ctx context.Context, req *domain.DeleteProductRequest)) *MockDB_DeleteProduct_Call {
+func (_c *MockDB_AdjustWarehouseStock_Call) Run(run func(ctx context.Context, req *domain.AdjustWarehouseStockRequest)) *MockDB_AdjustWarehouseStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.DeleteProductRequest))
+		run(args[0].(context.Context), args[1].(*domain.AdjustWarehouseStockRequest))
 	})
 	return _c
 }
 
-func (_c *MockDB_DeleteProduct_Call) Return(_a0 error) *MockDB_DeleteProduct_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDB_AdjustWarehouseStock_Call) Return(_a0 *domain.WarehouseStock, _a1 error) *MockDB_AdjustWarehouseStock_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_DeleteProduct_Call) RunAndReturn(run func(context.Context, *domain.DeleteProductRequest) error) *MockDB_DeleteProduct_Call {
+func (_c *MockDB_AdjustWarehouseStock_Call) RunAndReturn(run func(context.Context, *domain.AdjustWarehouseStockRequest) (*domain.WarehouseStock, error)) *MockDB_AdjustWarehouseStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProductByID provides a mock function with given fields: ctx, id
-func (_m *MockDB) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
+// CancelPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *MockDB) CancelPurchaseOrder(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProductByID")
+		panic("no return value specified for CancelPurchaseOrder")
 	}
 
-	var r0 *domain.Product
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Product, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Product); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
 		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDB_GetProductByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByID'
-type MockDB_GetProductByID_Call struct {
+// MockDB_CancelPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPurchaseOrder'
+type MockDB_CancelPurchaseOrder_Call struct {
 	*mock.Call
 }
 
-// GetProductByID is a helper method to define mock.On call
+// CancelPurchaseOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockDB_Expecter) GetProductByID(ctx interface{}, id interface{}) *MockDB_GetProductByID_Call {
-	return &MockDB_GetProductByID_Call{Call: _e.mock.On("GetProductByID", ctx, id)}
+func (_e *MockDB_Expecter) CancelPurchaseOrder(ctx interface{}, id interface{}) *MockDB_CancelPurchaseOrder_Call {
+	return &MockDB_CancelPurchaseOrder_Call{Call: _e.mock.On("CancelPurchaseOrder", ctx, id)}
 }
 
-func (_c *MockDB_GetProductByID_Call) Run(run func(ctx context.Context, id int64)) *MockDB_GetProductByID_Call {
+func (_c *MockDB_CancelPurchaseOrder_Call) Run(run func(ctx context.Context, id int64)) *MockDB_CancelPurchaseOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetProductByID_Call) Return(_a0 *domain.Product, _a1 error) *MockDB_GetProductByID_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDB_CancelPurchaseOrder_Call) Return(_a0 error) *MockDB_CancelPurchaseOrder_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDB_GetProductByID_Call) RunAndReturn(run func(context.Context, int64) (*domain.Product, error)) *MockDB_GetProductByID_Call {
+func (_c *MockDB_CancelPurchaseOrder_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_CancelPurchaseOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProducts provides a mock function with given fields: ctx, filter
-func (_m *MockDB) GetProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
-	ret := _m.Called(ctx, filter)
+// CancelStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockDB) CancelStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProducts")
+		panic("no return value specified for CancelStockTransfer")
 	}
 
-	var r0 int64
-	var r1 []*domain.Product
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) (int64, []*domain.Product, error)); ok {
-		return rf(ctx, filter)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) int64); ok {
-		r0 = rf(ctx, filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) []*domain.Product); ok {
-		r1 = rf(ctx, filter)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]*domain.Product)
-		}
+	return r0
+}
+
+// MockDB_CancelStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelStockTransfer'
+type MockDB_CancelStockTransfer_Call struct {
+	*mock.Call
+}
+
+// CancelStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) CancelStockTransfer(ctx interface{}, id interface{}) *MockDB_CancelStockTransfer_Call {
+	return &MockDB_CancelStockTransfer_Call{Call: _e.mock.On("CancelStockTransfer", ctx, id)}
+}
+
+func (_c *MockDB_CancelStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockDB_CancelStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_CancelStockTransfer_Call) Return(_a0 error) *MockDB_CancelStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_CancelStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_CancelStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmReservation provides a mock function with given fields: ctx, orderID
+func (_m *MockDB) ConfirmReservation(ctx context.Context, orderID string) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmReservation")
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
-		r2 = rf(ctx, filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderID)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1, r2
+	return r0
 }
 
-// MockDB_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
-type MockDB_GetProducts_Call struct {
+// MockDB_ConfirmReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmReservation'
+type MockDB_ConfirmReservation_Call struct {
 	*mock.Call
 }
 
-// GetProducts is a helper method to define mock.On call
+// ConfirmReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter domain.Filter
-func (_e *MockDB_Expecter) GetProducts(ctx interface{}, filter interface{}) *MockDB_GetProducts_Call {
-	return &MockDB_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, filter)}
+//   - orderID string
+func (_e *MockDB_Expecter) ConfirmReservation(ctx interface{}, orderID interface{}) *MockDB_ConfirmReservation_Call {
+	return &MockDB_ConfirmReservation_Call{Call: _e.mock.On("ConfirmReservation", ctx, orderID)}
 }
 
-func (_c *MockDB_GetProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockDB_GetProducts_Call {
+func (_c *MockDB_ConfirmReservation_Call) Run(run func(ctx context.Context, orderID string)) *MockDB_ConfirmReservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Filter))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetProducts_Call) Return(_a0 int64, _a1 []*domain.Product, _a2 error) *MockDB_GetProducts_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockDB_ConfirmReservation_Call) Return(_a0 error) *MockDB_ConfirmReservation_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDB_GetProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) (int64, []*domain.Product, error)) *MockDB_GetProducts_Call {
+func (_c *MockDB_ConfirmReservation_Call) RunAndReturn(run func(context.Context, string) error) *MockDB_ConfirmReservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsCurrencyCodeExists provides a mock function with given fields: ctx, currencyCode
-func (_m *MockDB) IsCurrencyCodeExists(ctx context.Context, currencyCode string) (bool, error) {
-	ret := _m.Called(ctx, currencyCode)
+// CreateCurrency provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateCurrency(ctx context.Context, req *domain.CreateCurrencyRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsCurrencyCodeExists")
+		panic("no return value specified for CreateCurrency")
 	}
 
-	var r0 bool
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return rf(ctx, currencyCode)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCurrencyRequest) (int64, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = rf(ctx, currencyCode)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCurrencyRequest) int64); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, currencyCode)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateCurrencyRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,56 +311,56 @@ func (_m *MockDB) IsCurrencyCodeExists(ctx context.Context, currencyCode string)
 	return r0, r1
 }
 
-// MockDB_IsCurrencyCodeExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCurrencyCodeExists'
-type MockDB_IsCurrencyCodeExists_Call struct {
+// MockDB_CreateCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrency'
+type MockDB_CreateCurrency_Call struct {
 	*mock.Call
 }
 
-// IsCurrencyCodeExists is a helper method to define mock.On call
+// CreateCurrency is a helper method to define mock.On call
 //   - ctx context.Context
-//   - currencyCode string
-func (_e *MockDB_Expecter) IsCurrencyCodeExists(ctx interface{}, currencyCode interface{}) *MockDB_IsCurrencyCodeExists_Call {
-	return &MockDB_IsCurrencyCodeExists_Call{Call: _e.mock.On("IsCurrencyCodeExists", ctx, currencyCode)}
+//   - req *domain.CreateCurrencyRequest
+func (_e *MockDB_Expecter) CreateCurrency(ctx interface{}, req interface{}) *MockDB_CreateCurrency_Call {
+	return &MockDB_CreateCurrency_Call{Call: _e.mock.On("CreateCurrency", ctx, req)}
 }
 
-func (_c *MockDB_IsCurrencyCodeExists_Call) Run(run func(ctx context.Context, currencyCode string)) *MockDB_IsCurrencyCodeExists_Call {
+func (_c *MockDB_CreateCurrency_Call) Run(run func(ctx context.Context, req *domain.CreateCurrencyRequest)) *MockDB_CreateCurrency_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(*domain.CreateCurrencyRequest))
 	})
 	return _c
 }
 
-func (_c *MockDB_IsCurrencyCodeExists_Call) Return(_a0 bool, _a1 error) *MockDB_IsCurrencyCodeExists_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDB_CreateCurrency_Call) Return(id int64, err error) *MockDB_CreateCurrency_Call {
+	_c.Call.Return(id, err)
 	return _c
 }
 
-func (_c *MockDB_IsCurrencyCodeExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDB_IsCurrencyCodeExists_Call {
+func (_c *MockDB_CreateCurrency_Call) RunAndReturn(run func(context.Context, *domain.CreateCurrencyRequest) (int64, error)) *MockDB_CreateCurrency_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsSubCategoryExists provides a mock function with given fields: ctx, subCategory
-func (_m *MockDB) IsSubCategoryExists(ctx context.Context, subCategory string) (bool, error) {
-	ret := _m.Called(ctx, subCategory)
+// CreateMainCategory provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateMainCategory(ctx context.Context, req *domain.CreateMainCategoryRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsSubCategoryExists")
+		panic("no return value specified for CreateMainCategory")
 	}
 
-	var r0 bool
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return rf(ctx, subCategory)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateMainCategoryRequest) (int64, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = rf(ctx, subCategory)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateMainCategoryRequest) int64); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, subCategory)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateMainCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -336,74 +368,3586 @@ func (_m *MockDB) IsSubCategoryExists(ctx context.Context, subCategory string) (
 	return r0, r1
 }
 
-// MockDB_IsSubCategoryExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSubCategoryExists'
-type MockDB_IsSubCategoryExists_Call struct {
+// MockDB_CreateMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMainCategory'
+type MockDB_CreateMainCategory_Call struct {
 	*mock.Call
 }
 
-// IsSubCategoryExists is a helper method to define mock.On call
+// CreateMainCategory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - subCategory string
-func (_e *MockDB_Expecter) IsSubCategoryExists(ctx interface{}, subCategory interface{}) *MockDB_IsSubCategoryExists_Call {
-	return &MockDB_IsSubCategoryExists_Call{Call: _e.mock.On("IsSubCategoryExists", ctx, subCategory)}
+//   - req *domain.CreateMainCategoryRequest
+func (_e *MockDB_Expecter) CreateMainCategory(ctx interface{}, req interface{}) *MockDB_CreateMainCategory_Call {
+	return &MockDB_CreateMainCategory_Call{Call: _e.mock.On("CreateMainCategory", ctx, req)}
 }
 
-func (_c *MockDB_IsSubCategoryExists_Call) Run(run func(ctx context.Context, subCategory string)) *MockDB_IsSubCategoryExists_Call {
+func (_c *MockDB_CreateMainCategory_Call) Run(run func(ctx context.Context, req *domain.CreateMainCategoryRequest)) *MockDB_CreateMainCategory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(*domain.CreateMainCategoryRequest))
 	})
 	return _c
 }
 
-func (_c *MockDB_IsSubCategoryExists_Call) Return(_a0 bool, _a1 error) *MockDB_IsSubCategoryExists_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDB_CreateMainCategory_Call) Return(id int64, err error) *MockDB_CreateMainCategory_Call {
+	_c.Call.Return(id, err)
 	return _c
 }
 
-func (_c *MockDB_IsSubCategoryExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDB_IsSubCategoryExists_Call {
+func (_c *MockDB_CreateMainCategory_Call) RunAndReturn(run func(context.Context, *domain.CreateMainCategoryRequest) (int64, error)) *MockDB_CreateMainCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProduct provides a mock function with given fields: ctx, req
-func (_m *MockDB) UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error {
+// CreateProduct provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateProduct(ctx context.Context, req *domain.CreateProductRequest) (int64, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProduct")
+		panic("no return value specified for CreateProduct")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateProductRequest) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateProductRequest) int64); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateProductRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDB_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
-type MockDB_UpdateProduct_Call struct {
+// MockDB_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
+type MockDB_CreateProduct_Call struct {
 	*mock.Call
 }
 
-// UpdateProduct is a helper method to define mock.On call
+// CreateProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *domain.UpdateProductRequest
-func (_e *MockDB_Expecter) UpdateProduct(ctx interface{}, req interface{}) *MockDB_UpdateProduct_Call {
-	return &MockDB_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, req)}
+//   - req *domain.CreateProductRequest
+func (_e *MockDB_Expecter) CreateProduct(ctx interface{}, req interface{}) *MockDB_CreateProduct_Call {
+	return &MockDB_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, req)}
 }
 
-func (_c *MockDB_UpdateProduct_Call) Run(run func(ctx context.Context, req *domain.UpdateProductRequest)) *MockDB_UpdateProduct_Call {
+func (_c *MockDB_CreateProduct_Call) Run(run func(ctx context.Context, req *domain.CreateProductRequest)) *MockDB_CreateProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.UpdateProductRequest))
+		run(args[0].(context.Context), args[1].(*domain.CreateProductRequest))
 	})
 	return _c
 }
 
-func (_c *MockDB_UpdateProduct_Call) Return(_a0 error) *MockDB_UpdateProduct_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDB_CreateProduct_Call) Return(id int64, err error) *MockDB_CreateProduct_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateProduct_Call) RunAndReturn(run func(context.Context, *domain.CreateProductRequest) (int64, error)) *MockDB_CreateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProducts provides a mock function with given fields: ctx, reqs
+func (_m *MockDB) CreateProducts(ctx context.Context, reqs []*domain.CreateProductRequest) ([]int64, error) {
+	ret := _m.Called(ctx, reqs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProducts")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.CreateProductRequest) ([]int64, error)); ok {
+		return rf(ctx, reqs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.CreateProductRequest) []int64); ok {
+		r0 = rf(ctx, reqs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.CreateProductRequest) error); ok {
+		r1 = rf(ctx, reqs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProducts'
+type MockDB_CreateProducts_Call struct {
+	*mock.Call
+}
+
+// CreateProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reqs []*domain.CreateProductRequest
+func (_e *MockDB_Expecter) CreateProducts(ctx interface{}, reqs interface{}) *MockDB_CreateProducts_Call {
+	return &MockDB_CreateProducts_Call{Call: _e.mock.On("CreateProducts", ctx, reqs)}
+}
+
+func (_c *MockDB_CreateProducts_Call) Run(run func(ctx context.Context, reqs []*domain.CreateProductRequest)) *MockDB_CreateProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*domain.CreateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateProducts_Call) Return(_a0 []int64, _a1 error) *MockDB_CreateProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_CreateProducts_Call) RunAndReturn(run func(context.Context, []*domain.CreateProductRequest) ([]int64, error)) *MockDB_CreateProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePurchaseOrder provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreatePurchaseOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePurchaseOrder")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreatePurchaseOrderRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreatePurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePurchaseOrder'
+type MockDB_CreatePurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// CreatePurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreatePurchaseOrderRequest
+func (_e *MockDB_Expecter) CreatePurchaseOrder(ctx interface{}, req interface{}) *MockDB_CreatePurchaseOrder_Call {
+	return &MockDB_CreatePurchaseOrder_Call{Call: _e.mock.On("CreatePurchaseOrder", ctx, req)}
+}
+
+func (_c *MockDB_CreatePurchaseOrder_Call) Run(run func(ctx context.Context, req *domain.CreatePurchaseOrderRequest)) *MockDB_CreatePurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreatePurchaseOrderRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreatePurchaseOrder_Call) Return(id int64, err error) *MockDB_CreatePurchaseOrder_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreatePurchaseOrder_Call) RunAndReturn(run func(context.Context, *domain.CreatePurchaseOrderRequest) (int64, error)) *MockDB_CreatePurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStockTransfer provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateStockTransfer(ctx context.Context, req *domain.CreateStockTransferRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockTransfer")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateStockTransferRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateStockTransferRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateStockTransferRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStockTransfer'
+type MockDB_CreateStockTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateStockTransferRequest
+func (_e *MockDB_Expecter) CreateStockTransfer(ctx interface{}, req interface{}) *MockDB_CreateStockTransfer_Call {
+	return &MockDB_CreateStockTransfer_Call{Call: _e.mock.On("CreateStockTransfer", ctx, req)}
+}
+
+func (_c *MockDB_CreateStockTransfer_Call) Run(run func(ctx context.Context, req *domain.CreateStockTransferRequest)) *MockDB_CreateStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateStockTransferRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateStockTransfer_Call) Return(id int64, err error) *MockDB_CreateStockTransfer_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateStockTransfer_Call) RunAndReturn(run func(context.Context, *domain.CreateStockTransferRequest) (int64, error)) *MockDB_CreateStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateSubCategory(ctx context.Context, req *domain.CreateSubCategoryRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSubCategoryRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSubCategoryRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSubCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubCategory'
+type MockDB_CreateSubCategory_Call struct {
+	*mock.Call
+}
+
+// CreateSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSubCategoryRequest
+func (_e *MockDB_Expecter) CreateSubCategory(ctx interface{}, req interface{}) *MockDB_CreateSubCategory_Call {
+	return &MockDB_CreateSubCategory_Call{Call: _e.mock.On("CreateSubCategory", ctx, req)}
+}
+
+func (_c *MockDB_CreateSubCategory_Call) Run(run func(ctx context.Context, req *domain.CreateSubCategoryRequest)) *MockDB_CreateSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateSubCategory_Call) Return(id int64, err error) *MockDB_CreateSubCategory_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateSubCategory_Call) RunAndReturn(run func(context.Context, *domain.CreateSubCategoryRequest) (int64, error)) *MockDB_CreateSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSupplier provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSupplier")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSupplierRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSupplier'
+type MockDB_CreateSupplier_Call struct {
+	*mock.Call
+}
+
+// CreateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSupplierRequest
+func (_e *MockDB_Expecter) CreateSupplier(ctx interface{}, req interface{}) *MockDB_CreateSupplier_Call {
+	return &MockDB_CreateSupplier_Call{Call: _e.mock.On("CreateSupplier", ctx, req)}
+}
+
+func (_c *MockDB_CreateSupplier_Call) Run(run func(ctx context.Context, req *domain.CreateSupplierRequest)) *MockDB_CreateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateSupplier_Call) Return(id int64, err error) *MockDB_CreateSupplier_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateSupplier_Call) RunAndReturn(run func(context.Context, *domain.CreateSupplierRequest) (int64, error)) *MockDB_CreateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateVariant provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateVariant(ctx context.Context, req *domain.CreateVariantRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariant")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateVariantRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateVariantRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateVariantRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVariant'
+type MockDB_CreateVariant_Call struct {
+	*mock.Call
+}
+
+// CreateVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateVariantRequest
+func (_e *MockDB_Expecter) CreateVariant(ctx interface{}, req interface{}) *MockDB_CreateVariant_Call {
+	return &MockDB_CreateVariant_Call{Call: _e.mock.On("CreateVariant", ctx, req)}
+}
+
+func (_c *MockDB_CreateVariant_Call) Run(run func(ctx context.Context, req *domain.CreateVariantRequest)) *MockDB_CreateVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateVariant_Call) Return(id int64, err error) *MockDB_CreateVariant_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateVariant_Call) RunAndReturn(run func(context.Context, *domain.CreateVariantRequest) (int64, error)) *MockDB_CreateVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, req
+func (_m *MockDB) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWarehouse")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateWarehouseRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateWarehouseRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateWarehouseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_CreateWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWarehouse'
+type MockDB_CreateWarehouse_Call struct {
+	*mock.Call
+}
+
+// CreateWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateWarehouseRequest
+func (_e *MockDB_Expecter) CreateWarehouse(ctx interface{}, req interface{}) *MockDB_CreateWarehouse_Call {
+	return &MockDB_CreateWarehouse_Call{Call: _e.mock.On("CreateWarehouse", ctx, req)}
+}
+
+func (_c *MockDB_CreateWarehouse_Call) Run(run func(ctx context.Context, req *domain.CreateWarehouseRequest)) *MockDB_CreateWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateWarehouseRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_CreateWarehouse_Call) Return(id int64, err error) *MockDB_CreateWarehouse_Call {
+	_c.Call.Return(id, err)
+	return _c
+}
+
+func (_c *MockDB_CreateWarehouse_Call) RunAndReturn(run func(context.Context, *domain.CreateWarehouseRequest) (int64, error)) *MockDB_CreateWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMainCategory provides a mock function with given fields: ctx, id
+func (_m *MockDB) DeleteMainCategory(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMainCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DeleteMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMainCategory'
+type MockDB_DeleteMainCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteMainCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) DeleteMainCategory(ctx interface{}, id interface{}) *MockDB_DeleteMainCategory_Call {
+	return &MockDB_DeleteMainCategory_Call{Call: _e.mock.On("DeleteMainCategory", ctx, id)}
+}
+
+func (_c *MockDB_DeleteMainCategory_Call) Run(run func(ctx context.Context, id int64)) *MockDB_DeleteMainCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_DeleteMainCategory_Call) Return(_a0 error) *MockDB_DeleteMainCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DeleteMainCategory_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_DeleteMainCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, req
+func (_m *MockDB) DeleteProduct(ctx context.Context, req *domain.DeleteProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockDB_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteProductRequest
+func (_e *MockDB_Expecter) DeleteProduct(ctx interface{}, req interface{}) *MockDB_DeleteProduct_Call {
+	return &MockDB_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, req)}
+}
+
+func (_c *MockDB_DeleteProduct_Call) Run(run func(ctx context.Context, req *domain.DeleteProductRequest)) *MockDB_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_DeleteProduct_Call) Return(_a0 error) *MockDB_DeleteProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DeleteProduct_Call) RunAndReturn(run func(context.Context, *domain.DeleteProductRequest) error) *MockDB_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockDB) DeleteSubCategory(ctx context.Context, req *domain.DeleteSubCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteSubCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DeleteSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubCategory'
+type MockDB_DeleteSubCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteSubCategoryRequest
+func (_e *MockDB_Expecter) DeleteSubCategory(ctx interface{}, req interface{}) *MockDB_DeleteSubCategory_Call {
+	return &MockDB_DeleteSubCategory_Call{Call: _e.mock.On("DeleteSubCategory", ctx, req)}
+}
+
+func (_c *MockDB_DeleteSubCategory_Call) Run(run func(ctx context.Context, req *domain.DeleteSubCategoryRequest)) *MockDB_DeleteSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_DeleteSubCategory_Call) Return(_a0 error) *MockDB_DeleteSubCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DeleteSubCategory_Call) RunAndReturn(run func(context.Context, *domain.DeleteSubCategoryRequest) error) *MockDB_DeleteSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSupplier provides a mock function with given fields: ctx, id
+func (_m *MockDB) DeleteSupplier(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DeleteSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSupplier'
+type MockDB_DeleteSupplier_Call struct {
+	*mock.Call
+}
+
+// DeleteSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) DeleteSupplier(ctx interface{}, id interface{}) *MockDB_DeleteSupplier_Call {
+	return &MockDB_DeleteSupplier_Call{Call: _e.mock.On("DeleteSupplier", ctx, id)}
+}
+
+func (_c *MockDB_DeleteSupplier_Call) Run(run func(ctx context.Context, id int64)) *MockDB_DeleteSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_DeleteSupplier_Call) Return(_a0 error) *MockDB_DeleteSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DeleteSupplier_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_DeleteSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteVariant provides a mock function with given fields: ctx, req
+func (_m *MockDB) DeleteVariant(ctx context.Context, req *domain.DeleteVariantRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteVariantRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DeleteVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteVariant'
+type MockDB_DeleteVariant_Call struct {
+	*mock.Call
+}
+
+// DeleteVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.DeleteVariantRequest
+func (_e *MockDB_Expecter) DeleteVariant(ctx interface{}, req interface{}) *MockDB_DeleteVariant_Call {
+	return &MockDB_DeleteVariant_Call{Call: _e.mock.On("DeleteVariant", ctx, req)}
+}
+
+func (_c *MockDB_DeleteVariant_Call) Run(run func(ctx context.Context, req *domain.DeleteVariantRequest)) *MockDB_DeleteVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeleteVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_DeleteVariant_Call) Return(_a0 error) *MockDB_DeleteVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DeleteVariant_Call) RunAndReturn(run func(context.Context, *domain.DeleteVariantRequest) error) *MockDB_DeleteVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DispatchStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockDB) DispatchStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchStockTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_DispatchStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchStockTransfer'
+type MockDB_DispatchStockTransfer_Call struct {
+	*mock.Call
+}
+
+// DispatchStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) DispatchStockTransfer(ctx interface{}, id interface{}) *MockDB_DispatchStockTransfer_Call {
+	return &MockDB_DispatchStockTransfer_Call{Call: _e.mock.On("DispatchStockTransfer", ctx, id)}
+}
+
+func (_c *MockDB_DispatchStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockDB_DispatchStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_DispatchStockTransfer_Call) Return(_a0 error) *MockDB_DispatchStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_DispatchStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_DispatchStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMissingCurrencyCodes provides a mock function with given fields: ctx, codes
+func (_m *MockDB) FindMissingCurrencyCodes(ctx context.Context, codes []string) ([]string, error) {
+	ret := _m.Called(ctx, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMissingCurrencyCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, codes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, codes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, codes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_FindMissingCurrencyCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMissingCurrencyCodes'
+type MockDB_FindMissingCurrencyCodes_Call struct {
+	*mock.Call
+}
+
+// FindMissingCurrencyCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - codes []string
+func (_e *MockDB_Expecter) FindMissingCurrencyCodes(ctx interface{}, codes interface{}) *MockDB_FindMissingCurrencyCodes_Call {
+	return &MockDB_FindMissingCurrencyCodes_Call{Call: _e.mock.On("FindMissingCurrencyCodes", ctx, codes)}
+}
+
+func (_c *MockDB_FindMissingCurrencyCodes_Call) Run(run func(ctx context.Context, codes []string)) *MockDB_FindMissingCurrencyCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDB_FindMissingCurrencyCodes_Call) Return(_a0 []string, _a1 error) *MockDB_FindMissingCurrencyCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_FindMissingCurrencyCodes_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *MockDB_FindMissingCurrencyCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMissingSubCategories provides a mock function with given fields: ctx, names
+func (_m *MockDB) FindMissingSubCategories(ctx context.Context, names []string) ([]string, error) {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMissingSubCategories")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_FindMissingSubCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMissingSubCategories'
+type MockDB_FindMissingSubCategories_Call struct {
+	*mock.Call
+}
+
+// FindMissingSubCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockDB_Expecter) FindMissingSubCategories(ctx interface{}, names interface{}) *MockDB_FindMissingSubCategories_Call {
+	return &MockDB_FindMissingSubCategories_Call{Call: _e.mock.On("FindMissingSubCategories", ctx, names)}
+}
+
+func (_c *MockDB_FindMissingSubCategories_Call) Run(run func(ctx context.Context, names []string)) *MockDB_FindMissingSubCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDB_FindMissingSubCategories_Call) Return(_a0 []string, _a1 error) *MockDB_FindMissingSubCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_FindMissingSubCategories_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *MockDB_FindMissingSubCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTakenIdentifiers provides a mock function with given fields: ctx, skus, barcodes
+func (_m *MockDB) FindTakenIdentifiers(ctx context.Context, skus []string, barcodes []string) ([]string, []string, error) {
+	ret := _m.Called(ctx, skus, barcodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTakenIdentifiers")
+	}
+
+	var r0 []string
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) ([]string, []string, error)); ok {
+		return rf(ctx, skus, barcodes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) []string); ok {
+		r0 = rf(ctx, skus, barcodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, []string) []string); ok {
+		r1 = rf(ctx, skus, barcodes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, []string) error); ok {
+		r2 = rf(ctx, skus, barcodes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_FindTakenIdentifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTakenIdentifiers'
+type MockDB_FindTakenIdentifiers_Call struct {
+	*mock.Call
+}
+
+// FindTakenIdentifiers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skus []string
+//   - barcodes []string
+func (_e *MockDB_Expecter) FindTakenIdentifiers(ctx interface{}, skus interface{}, barcodes interface{}) *MockDB_FindTakenIdentifiers_Call {
+	return &MockDB_FindTakenIdentifiers_Call{Call: _e.mock.On("FindTakenIdentifiers", ctx, skus, barcodes)}
+}
+
+func (_c *MockDB_FindTakenIdentifiers_Call) Run(run func(ctx context.Context, skus []string, barcodes []string)) *MockDB_FindTakenIdentifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDB_FindTakenIdentifiers_Call) Return(takenSKUs []string, takenBarcodes []string, err error) *MockDB_FindTakenIdentifiers_Call {
+	_c.Call.Return(takenSKUs, takenBarcodes, err)
+	return _c
+}
+
+func (_c *MockDB_FindTakenIdentifiers_Call) RunAndReturn(run func(context.Context, []string, []string) ([]string, []string, error)) *MockDB_FindTakenIdentifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategories provides a mock function with given fields: ctx
+func (_m *MockDB) GetCategories(ctx context.Context) ([]*domain.MainCategory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []*domain.MainCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.MainCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.MainCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.MainCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type MockDB_GetCategories_Call struct {
+	*mock.Call
+}
+
+// GetCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDB_Expecter) GetCategories(ctx interface{}) *MockDB_GetCategories_Call {
+	return &MockDB_GetCategories_Call{Call: _e.mock.On("GetCategories", ctx)}
+}
+
+func (_c *MockDB_GetCategories_Call) Run(run func(ctx context.Context)) *MockDB_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetCategories_Call) Return(_a0 []*domain.MainCategory, _a1 error) *MockDB_GetCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetCategories_Call) RunAndReturn(run func(context.Context) ([]*domain.MainCategory, error)) *MockDB_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCostLayers provides a mock function with given fields: ctx, productID
+func (_m *MockDB) GetCostLayers(ctx context.Context, productID int64) ([]*domain.CostLayer, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCostLayers")
+	}
+
+	var r0 []*domain.CostLayer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.CostLayer, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.CostLayer); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CostLayer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetCostLayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCostLayers'
+type MockDB_GetCostLayers_Call struct {
+	*mock.Call
+}
+
+// GetCostLayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockDB_Expecter) GetCostLayers(ctx interface{}, productID interface{}) *MockDB_GetCostLayers_Call {
+	return &MockDB_GetCostLayers_Call{Call: _e.mock.On("GetCostLayers", ctx, productID)}
+}
+
+func (_c *MockDB_GetCostLayers_Call) Run(run func(ctx context.Context, productID int64)) *MockDB_GetCostLayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetCostLayers_Call) Return(_a0 []*domain.CostLayer, _a1 error) *MockDB_GetCostLayers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetCostLayers_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.CostLayer, error)) *MockDB_GetCostLayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrencies provides a mock function with given fields: ctx, includeDisabled
+func (_m *MockDB) GetCurrencies(ctx context.Context, includeDisabled bool) ([]*domain.Currency, error) {
+	ret := _m.Called(ctx, includeDisabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrencies")
+	}
+
+	var r0 []*domain.Currency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*domain.Currency, error)); ok {
+		return rf(ctx, includeDisabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*domain.Currency); ok {
+		r0 = rf(ctx, includeDisabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Currency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeDisabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetCurrencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrencies'
+type MockDB_GetCurrencies_Call struct {
+	*mock.Call
+}
+
+// GetCurrencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeDisabled bool
+func (_e *MockDB_Expecter) GetCurrencies(ctx interface{}, includeDisabled interface{}) *MockDB_GetCurrencies_Call {
+	return &MockDB_GetCurrencies_Call{Call: _e.mock.On("GetCurrencies", ctx, includeDisabled)}
+}
+
+func (_c *MockDB_GetCurrencies_Call) Run(run func(ctx context.Context, includeDisabled bool)) *MockDB_GetCurrencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetCurrencies_Call) Return(_a0 []*domain.Currency, _a1 error) *MockDB_GetCurrencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetCurrencies_Call) RunAndReturn(run func(context.Context, bool) ([]*domain.Currency, error)) *MockDB_GetCurrencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrency provides a mock function with given fields: ctx, code
+func (_m *MockDB) GetCurrency(ctx context.Context, code string) (*domain.Currency, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrency")
+	}
+
+	var r0 *domain.Currency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Currency, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Currency); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Currency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrency'
+type MockDB_GetCurrency_Call struct {
+	*mock.Call
+}
+
+// GetCurrency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockDB_Expecter) GetCurrency(ctx interface{}, code interface{}) *MockDB_GetCurrency_Call {
+	return &MockDB_GetCurrency_Call{Call: _e.mock.On("GetCurrency", ctx, code)}
+}
+
+func (_c *MockDB_GetCurrency_Call) Run(run func(ctx context.Context, code string)) *MockDB_GetCurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetCurrency_Call) Return(_a0 *domain.Currency, _a1 error) *MockDB_GetCurrency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetCurrency_Call) RunAndReturn(run func(context.Context, string) (*domain.Currency, error)) *MockDB_GetCurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedProducts provides a mock function with given fields: ctx, filter
+func (_m *MockDB) GetDeletedProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedProducts")
+	}
+
+	var r0 int64
+	var r1 []*domain.Product
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) (int64, []*domain.Product, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_GetDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedProducts'
+type MockDB_GetDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// GetDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) GetDeletedProducts(ctx interface{}, filter interface{}) *MockDB_GetDeletedProducts_Call {
+	return &MockDB_GetDeletedProducts_Call{Call: _e.mock.On("GetDeletedProducts", ctx, filter)}
+}
+
+func (_c *MockDB_GetDeletedProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockDB_GetDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetDeletedProducts_Call) Return(_a0 int64, _a1 []*domain.Product, _a2 error) *MockDB_GetDeletedProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_GetDeletedProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) (int64, []*domain.Product, error)) *MockDB_GetDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExchangeRate provides a mock function with given fields: ctx, base, quote, at
+func (_m *MockDB) GetExchangeRate(ctx context.Context, base string, quote string, at time.Time) (*domain.ExchangeRate, error) {
+	ret := _m.Called(ctx, base, quote, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExchangeRate")
+	}
+
+	var r0 *domain.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*domain.ExchangeRate, error)); ok {
+		return rf(ctx, base, quote, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *domain.ExchangeRate); ok {
+		r0 = rf(ctx, base, quote, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, base, quote, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExchangeRate'
+type MockDB_GetExchangeRate_Call struct {
+	*mock.Call
+}
+
+// GetExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - base string
+//   - quote string
+//   - at time.Time
+func (_e *MockDB_Expecter) GetExchangeRate(ctx interface{}, base interface{}, quote interface{}, at interface{}) *MockDB_GetExchangeRate_Call {
+	return &MockDB_GetExchangeRate_Call{Call: _e.mock.On("GetExchangeRate", ctx, base, quote, at)}
+}
+
+func (_c *MockDB_GetExchangeRate_Call) Run(run func(ctx context.Context, base string, quote string, at time.Time)) *MockDB_GetExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetExchangeRate_Call) Return(_a0 *domain.ExchangeRate, _a1 error) *MockDB_GetExchangeRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetExchangeRate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (*domain.ExchangeRate, error)) *MockDB_GetExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLowStockProducts provides a mock function with given fields: ctx, filter
+func (_m *MockDB) GetLowStockProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowStockProducts")
+	}
+
+	var r0 int64
+	var r1 []*domain.Product
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) (int64, []*domain.Product, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_GetLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLowStockProducts'
+type MockDB_GetLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// GetLowStockProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) GetLowStockProducts(ctx interface{}, filter interface{}) *MockDB_GetLowStockProducts_Call {
+	return &MockDB_GetLowStockProducts_Call{Call: _e.mock.On("GetLowStockProducts", ctx, filter)}
+}
+
+func (_c *MockDB_GetLowStockProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockDB_GetLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetLowStockProducts_Call) Return(_a0 int64, _a1 []*domain.Product, _a2 error) *MockDB_GetLowStockProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_GetLowStockProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) (int64, []*domain.Product, error)) *MockDB_GetLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByBarcode provides a mock function with given fields: ctx, barcode
+func (_m *MockDB) GetProductByBarcode(ctx context.Context, barcode string) (*domain.Product, error) {
+	ret := _m.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByBarcode")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Product, error)); ok {
+		return rf(ctx, barcode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Product); ok {
+		r0 = rf(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type MockDB_GetProductByBarcode_Call struct {
+	*mock.Call
+}
+
+// GetProductByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockDB_Expecter) GetProductByBarcode(ctx interface{}, barcode interface{}) *MockDB_GetProductByBarcode_Call {
+	return &MockDB_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode", ctx, barcode)}
+}
+
+func (_c *MockDB_GetProductByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockDB_GetProductByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductByBarcode_Call) Return(_a0 *domain.Product, _a1 error) *MockDB_GetProductByBarcode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductByBarcode_Call) RunAndReturn(run func(context.Context, string) (*domain.Product, error)) *MockDB_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByID provides a mock function with given fields: ctx, id
+func (_m *MockDB) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Product, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Product); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByID'
+type MockDB_GetProductByID_Call struct {
+	*mock.Call
+}
+
+// GetProductByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) GetProductByID(ctx interface{}, id interface{}) *MockDB_GetProductByID_Call {
+	return &MockDB_GetProductByID_Call{Call: _e.mock.On("GetProductByID", ctx, id)}
+}
+
+func (_c *MockDB_GetProductByID_Call) Run(run func(ctx context.Context, id int64)) *MockDB_GetProductByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductByID_Call) Return(_a0 *domain.Product, _a1 error) *MockDB_GetProductByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductByID_Call) RunAndReturn(run func(context.Context, int64) (*domain.Product, error)) *MockDB_GetProductByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductBySKU provides a mock function with given fields: ctx, sku
+func (_m *MockDB) GetProductBySKU(ctx context.Context, sku string) (*domain.Product, error) {
+	ret := _m.Called(ctx, sku)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductBySKU")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Product, error)); ok {
+		return rf(ctx, sku)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Product); ok {
+		r0 = rf(ctx, sku)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBySKU'
+type MockDB_GetProductBySKU_Call struct {
+	*mock.Call
+}
+
+// GetProductBySKU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+func (_e *MockDB_Expecter) GetProductBySKU(ctx interface{}, sku interface{}) *MockDB_GetProductBySKU_Call {
+	return &MockDB_GetProductBySKU_Call{Call: _e.mock.On("GetProductBySKU", ctx, sku)}
+}
+
+func (_c *MockDB_GetProductBySKU_Call) Run(run func(ctx context.Context, sku string)) *MockDB_GetProductBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductBySKU_Call) Return(_a0 *domain.Product, _a1 error) *MockDB_GetProductBySKU_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductBySKU_Call) RunAndReturn(run func(context.Context, string) (*domain.Product, error)) *MockDB_GetProductBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductHistory provides a mock function with given fields: ctx, productID
+func (_m *MockDB) GetProductHistory(ctx context.Context, productID int64) ([]*domain.ProductSnapshot, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductHistory")
+	}
+
+	var r0 []*domain.ProductSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.ProductSnapshot, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.ProductSnapshot); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductHistory'
+type MockDB_GetProductHistory_Call struct {
+	*mock.Call
+}
+
+// GetProductHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockDB_Expecter) GetProductHistory(ctx interface{}, productID interface{}) *MockDB_GetProductHistory_Call {
+	return &MockDB_GetProductHistory_Call{Call: _e.mock.On("GetProductHistory", ctx, productID)}
+}
+
+func (_c *MockDB_GetProductHistory_Call) Run(run func(ctx context.Context, productID int64)) *MockDB_GetProductHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductHistory_Call) Return(_a0 []*domain.ProductSnapshot, _a1 error) *MockDB_GetProductHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductHistory_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.ProductSnapshot, error)) *MockDB_GetProductHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductSnapshot provides a mock function with given fields: ctx, productID, at
+func (_m *MockDB) GetProductSnapshot(ctx context.Context, productID int64, at time.Time) (*domain.ProductSnapshot, error) {
+	ret := _m.Called(ctx, productID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductSnapshot")
+	}
+
+	var r0 *domain.ProductSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*domain.ProductSnapshot, error)); ok {
+		return rf(ctx, productID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) *domain.ProductSnapshot); ok {
+		r0 = rf(ctx, productID, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, productID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductSnapshot'
+type MockDB_GetProductSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetProductSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+//   - at time.Time
+func (_e *MockDB_Expecter) GetProductSnapshot(ctx interface{}, productID interface{}, at interface{}) *MockDB_GetProductSnapshot_Call {
+	return &MockDB_GetProductSnapshot_Call{Call: _e.mock.On("GetProductSnapshot", ctx, productID, at)}
+}
+
+func (_c *MockDB_GetProductSnapshot_Call) Run(run func(ctx context.Context, productID int64, at time.Time)) *MockDB_GetProductSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductSnapshot_Call) Return(_a0 *domain.ProductSnapshot, _a1 error) *MockDB_GetProductSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductSnapshot_Call) RunAndReturn(run func(context.Context, int64, time.Time) (*domain.ProductSnapshot, error)) *MockDB_GetProductSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductStock provides a mock function with given fields: ctx, productID
+func (_m *MockDB) GetProductStock(ctx context.Context, productID int64) (*domain.ProductStock, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductStock")
+	}
+
+	var r0 *domain.ProductStock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.ProductStock, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.ProductStock); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductStock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductStock'
+type MockDB_GetProductStock_Call struct {
+	*mock.Call
+}
+
+// GetProductStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockDB_Expecter) GetProductStock(ctx interface{}, productID interface{}) *MockDB_GetProductStock_Call {
+	return &MockDB_GetProductStock_Call{Call: _e.mock.On("GetProductStock", ctx, productID)}
+}
+
+func (_c *MockDB_GetProductStock_Call) Run(run func(ctx context.Context, productID int64)) *MockDB_GetProductStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductStock_Call) Return(_a0 *domain.ProductStock, _a1 error) *MockDB_GetProductStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductStock_Call) RunAndReturn(run func(context.Context, int64) (*domain.ProductStock, error)) *MockDB_GetProductStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductSuppliers provides a mock function with given fields: ctx, productID
+func (_m *MockDB) GetProductSuppliers(ctx context.Context, productID int64) ([]*domain.ProductSupplier, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductSuppliers")
+	}
+
+	var r0 []*domain.ProductSupplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.ProductSupplier, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.ProductSupplier); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductSupplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductSuppliers'
+type MockDB_GetProductSuppliers_Call struct {
+	*mock.Call
+}
+
+// GetProductSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockDB_Expecter) GetProductSuppliers(ctx interface{}, productID interface{}) *MockDB_GetProductSuppliers_Call {
+	return &MockDB_GetProductSuppliers_Call{Call: _e.mock.On("GetProductSuppliers", ctx, productID)}
+}
+
+func (_c *MockDB_GetProductSuppliers_Call) Run(run func(ctx context.Context, productID int64)) *MockDB_GetProductSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductSuppliers_Call) Return(_a0 []*domain.ProductSupplier, _a1 error) *MockDB_GetProductSuppliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductSuppliers_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.ProductSupplier, error)) *MockDB_GetProductSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProducts provides a mock function with given fields: ctx, filter
+func (_m *MockDB) GetProducts(ctx context.Context, filter domain.Filter) (int64, []*domain.Product, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProducts")
+	}
+
+	var r0 int64
+	var r1 []*domain.Product
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) (int64, []*domain.Product, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) []*domain.Product); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_GetProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProducts'
+type MockDB_GetProducts_Call struct {
+	*mock.Call
+}
+
+// GetProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) GetProducts(ctx interface{}, filter interface{}) *MockDB_GetProducts_Call {
+	return &MockDB_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, filter)}
+}
+
+func (_c *MockDB_GetProducts_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockDB_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProducts_Call) Return(_a0 int64, _a1 []*domain.Product, _a2 error) *MockDB_GetProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_GetProducts_Call) RunAndReturn(run func(context.Context, domain.Filter) (int64, []*domain.Product, error)) *MockDB_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductsByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockDB) GetProductsByIDs(ctx context.Context, ids []int64) ([]*domain.Product, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsByIDs")
+	}
+
+	var r0 []*domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]*domain.Product, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []*domain.Product); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetProductsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductsByIDs'
+type MockDB_GetProductsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetProductsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockDB_Expecter) GetProductsByIDs(ctx interface{}, ids interface{}) *MockDB_GetProductsByIDs_Call {
+	return &MockDB_GetProductsByIDs_Call{Call: _e.mock.On("GetProductsByIDs", ctx, ids)}
+}
+
+func (_c *MockDB_GetProductsByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockDB_GetProductsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetProductsByIDs_Call) Return(_a0 []*domain.Product, _a1 error) *MockDB_GetProductsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetProductsByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]*domain.Product, error)) *MockDB_GetProductsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *MockDB) GetPurchaseOrder(ctx context.Context, id int64) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPurchaseOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurchaseOrder'
+type MockDB_GetPurchaseOrder_Call struct {
+	*mock.Call
+}
+
+// GetPurchaseOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) GetPurchaseOrder(ctx interface{}, id interface{}) *MockDB_GetPurchaseOrder_Call {
+	return &MockDB_GetPurchaseOrder_Call{Call: _e.mock.On("GetPurchaseOrder", ctx, id)}
+}
+
+func (_c *MockDB_GetPurchaseOrder_Call) Run(run func(ctx context.Context, id int64)) *MockDB_GetPurchaseOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPurchaseOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *MockDB_GetPurchaseOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPurchaseOrder_Call) RunAndReturn(run func(context.Context, int64) (*domain.PurchaseOrder, error)) *MockDB_GetPurchaseOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReservations provides a mock function with given fields: ctx, orderID
+func (_m *MockDB) GetReservations(ctx context.Context, orderID string) ([]*domain.Reservation, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReservations")
+	}
+
+	var r0 []*domain.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Reservation, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.Reservation); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReservations'
+type MockDB_GetReservations_Call struct {
+	*mock.Call
+}
+
+// GetReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+func (_e *MockDB_Expecter) GetReservations(ctx interface{}, orderID interface{}) *MockDB_GetReservations_Call {
+	return &MockDB_GetReservations_Call{Call: _e.mock.On("GetReservations", ctx, orderID)}
+}
+
+func (_c *MockDB_GetReservations_Call) Run(run func(ctx context.Context, orderID string)) *MockDB_GetReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetReservations_Call) Return(_a0 []*domain.Reservation, _a1 error) *MockDB_GetReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetReservations_Call) RunAndReturn(run func(context.Context, string) ([]*domain.Reservation, error)) *MockDB_GetReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockDiscrepancies provides a mock function with given fields: ctx, filter
+func (_m *MockDB) GetStockDiscrepancies(ctx context.Context, filter domain.Filter) (int64, []*domain.ProductStock, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockDiscrepancies")
+	}
+
+	var r0 int64
+	var r1 []*domain.ProductStock
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) (int64, []*domain.ProductStock, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) []*domain.ProductStock); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.ProductStock)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_GetStockDiscrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockDiscrepancies'
+type MockDB_GetStockDiscrepancies_Call struct {
+	*mock.Call
+}
+
+// GetStockDiscrepancies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) GetStockDiscrepancies(ctx interface{}, filter interface{}) *MockDB_GetStockDiscrepancies_Call {
+	return &MockDB_GetStockDiscrepancies_Call{Call: _e.mock.On("GetStockDiscrepancies", ctx, filter)}
+}
+
+func (_c *MockDB_GetStockDiscrepancies_Call) Run(run func(ctx context.Context, filter domain.Filter)) *MockDB_GetStockDiscrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetStockDiscrepancies_Call) Return(_a0 int64, _a1 []*domain.ProductStock, _a2 error) *MockDB_GetStockDiscrepancies_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_GetStockDiscrepancies_Call) RunAndReturn(run func(context.Context, domain.Filter) (int64, []*domain.ProductStock, error)) *MockDB_GetStockDiscrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockMovements provides a mock function with given fields: ctx, productID, filter
+func (_m *MockDB) GetStockMovements(ctx context.Context, productID int64, filter domain.Filter) (int64, []*domain.StockMovement, error) {
+	ret := _m.Called(ctx, productID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockMovements")
+	}
+
+	var r0 int64
+	var r1 []*domain.StockMovement
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Filter) (int64, []*domain.StockMovement, error)); ok {
+		return rf(ctx, productID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.Filter) int64); ok {
+		r0 = rf(ctx, productID, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, domain.Filter) []*domain.StockMovement); ok {
+		r1 = rf(ctx, productID, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, domain.Filter) error); ok {
+		r2 = rf(ctx, productID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_GetStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockMovements'
+type MockDB_GetStockMovements_Call struct {
+	*mock.Call
+}
+
+// GetStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) GetStockMovements(ctx interface{}, productID interface{}, filter interface{}) *MockDB_GetStockMovements_Call {
+	return &MockDB_GetStockMovements_Call{Call: _e.mock.On("GetStockMovements", ctx, productID, filter)}
+}
+
+func (_c *MockDB_GetStockMovements_Call) Run(run func(ctx context.Context, productID int64, filter domain.Filter)) *MockDB_GetStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetStockMovements_Call) Return(_a0 int64, _a1 []*domain.StockMovement, _a2 error) *MockDB_GetStockMovements_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_GetStockMovements_Call) RunAndReturn(run func(context.Context, int64, domain.Filter) (int64, []*domain.StockMovement, error)) *MockDB_GetStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockDB) GetStockTransfer(ctx context.Context, id int64) (*domain.StockTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockTransfer")
+	}
+
+	var r0 *domain.StockTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.StockTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.StockTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StockTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockTransfer'
+type MockDB_GetStockTransfer_Call struct {
+	*mock.Call
+}
+
+// GetStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) GetStockTransfer(ctx interface{}, id interface{}) *MockDB_GetStockTransfer_Call {
+	return &MockDB_GetStockTransfer_Call{Call: _e.mock.On("GetStockTransfer", ctx, id)}
+}
+
+func (_c *MockDB_GetStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockDB_GetStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetStockTransfer_Call) Return(_a0 *domain.StockTransfer, _a1 error) *MockDB_GetStockTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetStockTransfer_Call) RunAndReturn(run func(context.Context, int64) (*domain.StockTransfer, error)) *MockDB_GetStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSupplier provides a mock function with given fields: ctx, id
+func (_m *MockDB) GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSupplier")
+	}
+
+	var r0 *domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*domain.Supplier, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *domain.Supplier); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSupplier'
+type MockDB_GetSupplier_Call struct {
+	*mock.Call
+}
+
+// GetSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) GetSupplier(ctx interface{}, id interface{}) *MockDB_GetSupplier_Call {
+	return &MockDB_GetSupplier_Call{Call: _e.mock.On("GetSupplier", ctx, id)}
+}
+
+func (_c *MockDB_GetSupplier_Call) Run(run func(ctx context.Context, id int64)) *MockDB_GetSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetSupplier_Call) Return(_a0 *domain.Supplier, _a1 error) *MockDB_GetSupplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetSupplier_Call) RunAndReturn(run func(context.Context, int64) (*domain.Supplier, error)) *MockDB_GetSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuppliers provides a mock function with given fields: ctx
+func (_m *MockDB) GetSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuppliers")
+	}
+
+	var r0 []*domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Supplier, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Supplier); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuppliers'
+type MockDB_GetSuppliers_Call struct {
+	*mock.Call
+}
+
+// GetSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDB_Expecter) GetSuppliers(ctx interface{}) *MockDB_GetSuppliers_Call {
+	return &MockDB_GetSuppliers_Call{Call: _e.mock.On("GetSuppliers", ctx)}
+}
+
+func (_c *MockDB_GetSuppliers_Call) Run(run func(ctx context.Context)) *MockDB_GetSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetSuppliers_Call) Return(_a0 []*domain.Supplier, _a1 error) *MockDB_GetSuppliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetSuppliers_Call) RunAndReturn(run func(context.Context) ([]*domain.Supplier, error)) *MockDB_GetSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetValuation provides a mock function with given fields: ctx
+func (_m *MockDB) GetValuation(ctx context.Context) ([]domain.ValuationLine, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValuation")
+	}
+
+	var r0 []domain.ValuationLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.ValuationLine, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.ValuationLine); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ValuationLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValuation'
+type MockDB_GetValuation_Call struct {
+	*mock.Call
+}
+
+// GetValuation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDB_Expecter) GetValuation(ctx interface{}) *MockDB_GetValuation_Call {
+	return &MockDB_GetValuation_Call{Call: _e.mock.On("GetValuation", ctx)}
+}
+
+func (_c *MockDB_GetValuation_Call) Run(run func(ctx context.Context)) *MockDB_GetValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetValuation_Call) Return(_a0 []domain.ValuationLine, _a1 error) *MockDB_GetValuation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetValuation_Call) RunAndReturn(run func(context.Context) ([]domain.ValuationLine, error)) *MockDB_GetValuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVariants provides a mock function with given fields: ctx, productID
+func (_m *MockDB) GetVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	ret := _m.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariants")
+	}
+
+	var r0 []domain.Variant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.Variant, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.Variant); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Variant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetVariants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVariants'
+type MockDB_GetVariants_Call struct {
+	*mock.Call
+}
+
+// GetVariants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int64
+func (_e *MockDB_Expecter) GetVariants(ctx interface{}, productID interface{}) *MockDB_GetVariants_Call {
+	return &MockDB_GetVariants_Call{Call: _e.mock.On("GetVariants", ctx, productID)}
+}
+
+func (_c *MockDB_GetVariants_Call) Run(run func(ctx context.Context, productID int64)) *MockDB_GetVariants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetVariants_Call) Return(_a0 []domain.Variant, _a1 error) *MockDB_GetVariants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetVariants_Call) RunAndReturn(run func(context.Context, int64) ([]domain.Variant, error)) *MockDB_GetVariants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWarehouses provides a mock function with given fields: ctx
+func (_m *MockDB) GetWarehouses(ctx context.Context) ([]*domain.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWarehouses")
+	}
+
+	var r0 []*domain.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouses'
+type MockDB_GetWarehouses_Call struct {
+	*mock.Call
+}
+
+// GetWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDB_Expecter) GetWarehouses(ctx interface{}) *MockDB_GetWarehouses_Call {
+	return &MockDB_GetWarehouses_Call{Call: _e.mock.On("GetWarehouses", ctx)}
+}
+
+func (_c *MockDB_GetWarehouses_Call) Run(run func(ctx context.Context)) *MockDB_GetWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetWarehouses_Call) Return(_a0 []*domain.Warehouse, _a1 error) *MockDB_GetWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetWarehouses_Call) RunAndReturn(run func(context.Context) ([]*domain.Warehouse, error)) *MockDB_GetWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCurrencyCodeExists provides a mock function with given fields: ctx, currencyCode
+func (_m *MockDB) IsCurrencyCodeExists(ctx context.Context, currencyCode string) (bool, error) {
+	ret := _m.Called(ctx, currencyCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCurrencyCodeExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, currencyCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, currencyCode)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, currencyCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_IsCurrencyCodeExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCurrencyCodeExists'
+type MockDB_IsCurrencyCodeExists_Call struct {
+	*mock.Call
+}
+
+// IsCurrencyCodeExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - currencyCode string
+func (_e *MockDB_Expecter) IsCurrencyCodeExists(ctx interface{}, currencyCode interface{}) *MockDB_IsCurrencyCodeExists_Call {
+	return &MockDB_IsCurrencyCodeExists_Call{Call: _e.mock.On("IsCurrencyCodeExists", ctx, currencyCode)}
+}
+
+func (_c *MockDB_IsCurrencyCodeExists_Call) Run(run func(ctx context.Context, currencyCode string)) *MockDB_IsCurrencyCodeExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_IsCurrencyCodeExists_Call) Return(_a0 bool, _a1 error) *MockDB_IsCurrencyCodeExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_IsCurrencyCodeExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDB_IsCurrencyCodeExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSubCategoryExists provides a mock function with given fields: ctx, subCategory
+func (_m *MockDB) IsSubCategoryExists(ctx context.Context, subCategory string) (bool, error) {
+	ret := _m.Called(ctx, subCategory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSubCategoryExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, subCategory)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, subCategory)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subCategory)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_IsSubCategoryExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSubCategoryExists'
+type MockDB_IsSubCategoryExists_Call struct {
+	*mock.Call
+}
+
+// IsSubCategoryExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subCategory string
+func (_e *MockDB_Expecter) IsSubCategoryExists(ctx interface{}, subCategory interface{}) *MockDB_IsSubCategoryExists_Call {
+	return &MockDB_IsSubCategoryExists_Call{Call: _e.mock.On("IsSubCategoryExists", ctx, subCategory)}
+}
+
+func (_c *MockDB_IsSubCategoryExists_Call) Run(run func(ctx context.Context, subCategory string)) *MockDB_IsSubCategoryExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_IsSubCategoryExists_Call) Return(_a0 bool, _a1 error) *MockDB_IsSubCategoryExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_IsSubCategoryExists_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDB_IsSubCategoryExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListenProductChanges provides a mock function with given fields: ctx, fn
+func (_m *MockDB) ListenProductChanges(ctx context.Context, fn func(*domain.ProductChangeEvent)) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListenProductChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*domain.ProductChangeEvent)) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_ListenProductChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListenProductChanges'
+type MockDB_ListenProductChanges_Call struct {
+	*mock.Call
+}
+
+// ListenProductChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(*domain.ProductChangeEvent)
+func (_e *MockDB_Expecter) ListenProductChanges(ctx interface{}, fn interface{}) *MockDB_ListenProductChanges_Call {
+	return &MockDB_ListenProductChanges_Call{Call: _e.mock.On("ListenProductChanges", ctx, fn)}
+}
+
+func (_c *MockDB_ListenProductChanges_Call) Run(run func(ctx context.Context, fn func(*domain.ProductChangeEvent))) *MockDB_ListenProductChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(*domain.ProductChangeEvent)))
+	})
+	return _c
+}
+
+func (_c *MockDB_ListenProductChanges_Call) Return(_a0 error) *MockDB_ListenProductChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_ListenProductChanges_Call) RunAndReturn(run func(context.Context, func(*domain.ProductChangeEvent)) error) *MockDB_ListenProductChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyEvents provides a mock function with given fields: ctx, limit, notify
+func (_m *MockDB) NotifyEvents(ctx context.Context, limit int, notify func([]*domain.Event) error) (int64, error) {
+	ret := _m.Called(ctx, limit, notify)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, func([]*domain.Event) error) (int64, error)); ok {
+		return rf(ctx, limit, notify)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, func([]*domain.Event) error) int64); ok {
+		r0 = rf(ctx, limit, notify)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, func([]*domain.Event) error) error); ok {
+		r1 = rf(ctx, limit, notify)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_NotifyEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyEvents'
+type MockDB_NotifyEvents_Call struct {
+	*mock.Call
+}
+
+// NotifyEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - notify func([]*domain.Event) error
+func (_e *MockDB_Expecter) NotifyEvents(ctx interface{}, limit interface{}, notify interface{}) *MockDB_NotifyEvents_Call {
+	return &MockDB_NotifyEvents_Call{Call: _e.mock.On("NotifyEvents", ctx, limit, notify)}
+}
+
+func (_c *MockDB_NotifyEvents_Call) Run(run func(ctx context.Context, limit int, notify func([]*domain.Event) error)) *MockDB_NotifyEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(func([]*domain.Event) error))
+	})
+	return _c
+}
+
+func (_c *MockDB_NotifyEvents_Call) Return(_a0 int64, _a1 error) *MockDB_NotifyEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_NotifyEvents_Call) RunAndReturn(run func(context.Context, int, func([]*domain.Event) error) (int64, error)) *MockDB_NotifyEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedProducts provides a mock function with given fields: ctx, before, limit
+func (_m *MockDB) PurgeDeletedProducts(ctx context.Context, before time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedProducts")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_PurgeDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedProducts'
+type MockDB_PurgeDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockDB_Expecter) PurgeDeletedProducts(ctx interface{}, before interface{}, limit interface{}) *MockDB_PurgeDeletedProducts_Call {
+	return &MockDB_PurgeDeletedProducts_Call{Call: _e.mock.On("PurgeDeletedProducts", ctx, before, limit)}
+}
+
+func (_c *MockDB_PurgeDeletedProducts_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockDB_PurgeDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDB_PurgeDeletedProducts_Call) Return(_a0 int64, _a1 error) *MockDB_PurgeDeletedProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_PurgeDeletedProducts_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *MockDB_PurgeDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeOutboxEvents provides a mock function with given fields: ctx, before, unpublished, unnotified, limit
+func (_m *MockDB) PurgeOutboxEvents(ctx context.Context, before time.Time, unpublished bool, unnotified bool, limit int) (int64, error) {
+	ret := _m.Called(ctx, before, unpublished, unnotified, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeOutboxEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, bool, bool, int) (int64, error)); ok {
+		return rf(ctx, before, unpublished, unnotified, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, bool, bool, int) int64); ok {
+		r0 = rf(ctx, before, unpublished, unnotified, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, bool, bool, int) error); ok {
+		r1 = rf(ctx, before, unpublished, unnotified, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_PurgeOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeOutboxEvents'
+type MockDB_PurgeOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// PurgeOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - unpublished bool
+//   - unnotified bool
+//   - limit int
+func (_e *MockDB_Expecter) PurgeOutboxEvents(ctx interface{}, before interface{}, unpublished interface{}, unnotified interface{}, limit interface{}) *MockDB_PurgeOutboxEvents_Call {
+	return &MockDB_PurgeOutboxEvents_Call{Call: _e.mock.On("PurgeOutboxEvents", ctx, before, unpublished, unnotified, limit)}
+}
+
+func (_c *MockDB_PurgeOutboxEvents_Call) Run(run func(ctx context.Context, before time.Time, unpublished bool, unnotified bool, limit int)) *MockDB_PurgeOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(bool), args[3].(bool), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockDB_PurgeOutboxEvents_Call) Return(_a0 int64, _a1 error) *MockDB_PurgeOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_PurgeOutboxEvents_Call) RunAndReturn(run func(context.Context, time.Time, bool, bool, int) (int64, error)) *MockDB_PurgeOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveGoods provides a mock function with given fields: ctx, req, method
+func (_m *MockDB) ReceiveGoods(ctx context.Context, req *domain.ReceiveGoodsRequest, method domain.CostMethod) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, req, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveGoods")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReceiveGoodsRequest, domain.CostMethod) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, req, method)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReceiveGoodsRequest, domain.CostMethod) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, req, method)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ReceiveGoodsRequest, domain.CostMethod) error); ok {
+		r1 = rf(ctx, req, method)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_ReceiveGoods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveGoods'
+type MockDB_ReceiveGoods_Call struct {
+	*mock.Call
+}
+
+// ReceiveGoods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ReceiveGoodsRequest
+//   - method domain.CostMethod
+func (_e *MockDB_Expecter) ReceiveGoods(ctx interface{}, req interface{}, method interface{}) *MockDB_ReceiveGoods_Call {
+	return &MockDB_ReceiveGoods_Call{Call: _e.mock.On("ReceiveGoods", ctx, req, method)}
+}
+
+func (_c *MockDB_ReceiveGoods_Call) Run(run func(ctx context.Context, req *domain.ReceiveGoodsRequest, method domain.CostMethod)) *MockDB_ReceiveGoods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReceiveGoodsRequest), args[2].(domain.CostMethod))
+	})
+	return _c
+}
+
+func (_c *MockDB_ReceiveGoods_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *MockDB_ReceiveGoods_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_ReceiveGoods_Call) RunAndReturn(run func(context.Context, *domain.ReceiveGoodsRequest, domain.CostMethod) (*domain.PurchaseOrder, error)) *MockDB_ReceiveGoods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveStockTransfer provides a mock function with given fields: ctx, id
+func (_m *MockDB) ReceiveStockTransfer(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveStockTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_ReceiveStockTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveStockTransfer'
+type MockDB_ReceiveStockTransfer_Call struct {
+	*mock.Call
+}
+
+// ReceiveStockTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) ReceiveStockTransfer(ctx interface{}, id interface{}) *MockDB_ReceiveStockTransfer_Call {
+	return &MockDB_ReceiveStockTransfer_Call{Call: _e.mock.On("ReceiveStockTransfer", ctx, id)}
+}
+
+func (_c *MockDB_ReceiveStockTransfer_Call) Run(run func(ctx context.Context, id int64)) *MockDB_ReceiveStockTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_ReceiveStockTransfer_Call) Return(_a0 error) *MockDB_ReceiveStockTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_ReceiveStockTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_ReceiveStockTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RelayEvents provides a mock function with given fields: ctx, limit, publish
+func (_m *MockDB) RelayEvents(ctx context.Context, limit int, publish func([]*domain.Event) error) (int64, error) {
+	ret := _m.Called(ctx, limit, publish)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RelayEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, func([]*domain.Event) error) (int64, error)); ok {
+		return rf(ctx, limit, publish)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, func([]*domain.Event) error) int64); ok {
+		r0 = rf(ctx, limit, publish)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, func([]*domain.Event) error) error); ok {
+		r1 = rf(ctx, limit, publish)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_RelayEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelayEvents'
+type MockDB_RelayEvents_Call struct {
+	*mock.Call
+}
+
+// RelayEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - publish func([]*domain.Event) error
+func (_e *MockDB_Expecter) RelayEvents(ctx interface{}, limit interface{}, publish interface{}) *MockDB_RelayEvents_Call {
+	return &MockDB_RelayEvents_Call{Call: _e.mock.On("RelayEvents", ctx, limit, publish)}
+}
+
+func (_c *MockDB_RelayEvents_Call) Run(run func(ctx context.Context, limit int, publish func([]*domain.Event) error)) *MockDB_RelayEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(func([]*domain.Event) error))
+	})
+	return _c
+}
+
+func (_c *MockDB_RelayEvents_Call) Return(_a0 int64, _a1 error) *MockDB_RelayEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_RelayEvents_Call) RunAndReturn(run func(context.Context, int, func([]*domain.Event) error) (int64, error)) *MockDB_RelayEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseExpiredReservations provides a mock function with given fields: ctx, before, limit
+func (_m *MockDB) ReleaseExpiredReservations(ctx context.Context, before time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseExpiredReservations")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_ReleaseExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiredReservations'
+type MockDB_ReleaseExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockDB_Expecter) ReleaseExpiredReservations(ctx interface{}, before interface{}, limit interface{}) *MockDB_ReleaseExpiredReservations_Call {
+	return &MockDB_ReleaseExpiredReservations_Call{Call: _e.mock.On("ReleaseExpiredReservations", ctx, before, limit)}
+}
+
+func (_c *MockDB_ReleaseExpiredReservations_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockDB_ReleaseExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDB_ReleaseExpiredReservations_Call) Return(_a0 int64, _a1 error) *MockDB_ReleaseExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_ReleaseExpiredReservations_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *MockDB_ReleaseExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, orderID
+func (_m *MockDB) ReleaseReservation(ctx context.Context, orderID string) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type MockDB_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+func (_e *MockDB_Expecter) ReleaseReservation(ctx interface{}, orderID interface{}) *MockDB_ReleaseReservation_Call {
+	return &MockDB_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, orderID)}
+}
+
+func (_c *MockDB_ReleaseReservation_Call) Run(run func(ctx context.Context, orderID string)) *MockDB_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_ReleaseReservation_Call) Return(_a0 error) *MockDB_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *MockDB_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProductSupplier provides a mock function with given fields: ctx, req
+func (_m *MockDB) RemoveProductSupplier(ctx context.Context, req *domain.RemoveProductSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProductSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RemoveProductSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_RemoveProductSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProductSupplier'
+type MockDB_RemoveProductSupplier_Call struct {
+	*mock.Call
+}
+
+// RemoveProductSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.RemoveProductSupplierRequest
+func (_e *MockDB_Expecter) RemoveProductSupplier(ctx interface{}, req interface{}) *MockDB_RemoveProductSupplier_Call {
+	return &MockDB_RemoveProductSupplier_Call{Call: _e.mock.On("RemoveProductSupplier", ctx, req)}
+}
+
+func (_c *MockDB_RemoveProductSupplier_Call) Run(run func(ctx context.Context, req *domain.RemoveProductSupplierRequest)) *MockDB_RemoveProductSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RemoveProductSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_RemoveProductSupplier_Call) Return(_a0 error) *MockDB_RemoveProductSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_RemoveProductSupplier_Call) RunAndReturn(run func(context.Context, *domain.RemoveProductSupplierRequest) error) *MockDB_RemoveProductSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, req
+func (_m *MockDB) ReserveStock(ctx context.Context, req *domain.ReserveStockRequest) (*domain.Reservation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 *domain.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReserveStockRequest) (*domain.Reservation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ReserveStockRequest) *domain.Reservation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ReserveStockRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockDB_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.ReserveStockRequest
+func (_e *MockDB_Expecter) ReserveStock(ctx interface{}, req interface{}) *MockDB_ReserveStock_Call {
+	return &MockDB_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, req)}
+}
+
+func (_c *MockDB_ReserveStock_Call) Run(run func(ctx context.Context, req *domain.ReserveStockRequest)) *MockDB_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ReserveStockRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_ReserveStock_Call) Return(_a0 *domain.Reservation, _a1 error) *MockDB_ReserveStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_ReserveStock_Call) RunAndReturn(run func(context.Context, *domain.ReserveStockRequest) (*domain.Reservation, error)) *MockDB_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function with given fields: ctx, id
+func (_m *MockDB) RestoreProduct(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockDB_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDB_Expecter) RestoreProduct(ctx interface{}, id interface{}) *MockDB_RestoreProduct_Call {
+	return &MockDB_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, id)}
+}
+
+func (_c *MockDB_RestoreProduct_Call) Run(run func(ctx context.Context, id int64)) *MockDB_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDB_RestoreProduct_Call) Return(_a0 error) *MockDB_RestoreProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_RestoreProduct_Call) RunAndReturn(run func(context.Context, int64) error) *MockDB_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchProducts provides a mock function with given fields: ctx, query, filter
+func (_m *MockDB) SearchProducts(ctx context.Context, query string, filter domain.Filter) (int64, []*domain.Product, error) {
+	ret := _m.Called(ctx, query, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchProducts")
+	}
+
+	var r0 int64
+	var r1 []*domain.Product
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Filter) (int64, []*domain.Product, error)); ok {
+		return rf(ctx, query, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Filter) int64); ok {
+		r0 = rf(ctx, query, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.Filter) []*domain.Product); ok {
+		r1 = rf(ctx, query, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, domain.Filter) error); ok {
+		r2 = rf(ctx, query, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDB_SearchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchProducts'
+type MockDB_SearchProducts_Call struct {
+	*mock.Call
+}
+
+// SearchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - filter domain.Filter
+func (_e *MockDB_Expecter) SearchProducts(ctx interface{}, query interface{}, filter interface{}) *MockDB_SearchProducts_Call {
+	return &MockDB_SearchProducts_Call{Call: _e.mock.On("SearchProducts", ctx, query, filter)}
+}
+
+func (_c *MockDB_SearchProducts_Call) Run(run func(ctx context.Context, query string, filter domain.Filter)) *MockDB_SearchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.Filter))
+	})
+	return _c
+}
+
+func (_c *MockDB_SearchProducts_Call) Return(_a0 int64, _a1 []*domain.Product, _a2 error) *MockDB_SearchProducts_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDB_SearchProducts_Call) RunAndReturn(run func(context.Context, string, domain.Filter) (int64, []*domain.Product, error)) *MockDB_SearchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCurrencyDisabled provides a mock function with given fields: ctx, code, disabled
+func (_m *MockDB) SetCurrencyDisabled(ctx context.Context, code string, disabled bool) error {
+	ret := _m.Called(ctx, code, disabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCurrencyDisabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, code, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_SetCurrencyDisabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCurrencyDisabled'
+type MockDB_SetCurrencyDisabled_Call struct {
+	*mock.Call
+}
+
+// SetCurrencyDisabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - disabled bool
+func (_e *MockDB_Expecter) SetCurrencyDisabled(ctx interface{}, code interface{}, disabled interface{}) *MockDB_SetCurrencyDisabled_Call {
+	return &MockDB_SetCurrencyDisabled_Call{Call: _e.mock.On("SetCurrencyDisabled", ctx, code, disabled)}
+}
+
+func (_c *MockDB_SetCurrencyDisabled_Call) Run(run func(ctx context.Context, code string, disabled bool)) *MockDB_SetCurrencyDisabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDB_SetCurrencyDisabled_Call) Return(_a0 error) *MockDB_SetCurrencyDisabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_SetCurrencyDisabled_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockDB_SetCurrencyDisabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetExchangeRate provides a mock function with given fields: ctx, req
+func (_m *MockDB) SetExchangeRate(ctx context.Context, req *domain.SetExchangeRateRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetExchangeRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SetExchangeRateRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_SetExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExchangeRate'
+type MockDB_SetExchangeRate_Call struct {
+	*mock.Call
+}
+
+// SetExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.SetExchangeRateRequest
+func (_e *MockDB_Expecter) SetExchangeRate(ctx interface{}, req interface{}) *MockDB_SetExchangeRate_Call {
+	return &MockDB_SetExchangeRate_Call{Call: _e.mock.On("SetExchangeRate", ctx, req)}
+}
+
+func (_c *MockDB_SetExchangeRate_Call) Run(run func(ctx context.Context, req *domain.SetExchangeRateRequest)) *MockDB_SetExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SetExchangeRateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_SetExchangeRate_Call) Return(_a0 error) *MockDB_SetExchangeRate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_SetExchangeRate_Call) RunAndReturn(run func(context.Context, *domain.SetExchangeRateRequest) error) *MockDB_SetExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProductSupplier provides a mock function with given fields: ctx, req
+func (_m *MockDB) SetProductSupplier(ctx context.Context, req *domain.SetProductSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProductSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SetProductSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_SetProductSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProductSupplier'
+type MockDB_SetProductSupplier_Call struct {
+	*mock.Call
+}
+
+// SetProductSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.SetProductSupplierRequest
+func (_e *MockDB_Expecter) SetProductSupplier(ctx interface{}, req interface{}) *MockDB_SetProductSupplier_Call {
+	return &MockDB_SetProductSupplier_Call{Call: _e.mock.On("SetProductSupplier", ctx, req)}
+}
+
+func (_c *MockDB_SetProductSupplier_Call) Run(run func(ctx context.Context, req *domain.SetProductSupplierRequest)) *MockDB_SetProductSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SetProductSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_SetProductSupplier_Call) Return(_a0 error) *MockDB_SetProductSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_SetProductSupplier_Call) RunAndReturn(run func(context.Context, *domain.SetProductSupplierRequest) error) *MockDB_SetProductSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMainCategory provides a mock function with given fields: ctx, req
+func (_m *MockDB) UpdateMainCategory(ctx context.Context, req *domain.UpdateMainCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMainCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateMainCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateMainCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMainCategory'
+type MockDB_UpdateMainCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateMainCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateMainCategoryRequest
+func (_e *MockDB_Expecter) UpdateMainCategory(ctx interface{}, req interface{}) *MockDB_UpdateMainCategory_Call {
+	return &MockDB_UpdateMainCategory_Call{Call: _e.mock.On("UpdateMainCategory", ctx, req)}
+}
+
+func (_c *MockDB_UpdateMainCategory_Call) Run(run func(ctx context.Context, req *domain.UpdateMainCategoryRequest)) *MockDB_UpdateMainCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateMainCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateMainCategory_Call) Return(_a0 error) *MockDB_UpdateMainCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateMainCategory_Call) RunAndReturn(run func(context.Context, *domain.UpdateMainCategoryRequest) error) *MockDB_UpdateMainCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function with given fields: ctx, req
+func (_m *MockDB) UpdateProduct(ctx context.Context, req *domain.UpdateProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type MockDB_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateProductRequest
+func (_e *MockDB_Expecter) UpdateProduct(ctx interface{}, req interface{}) *MockDB_UpdateProduct_Call {
+	return &MockDB_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, req)}
+}
+
+func (_c *MockDB_UpdateProduct_Call) Run(run func(ctx context.Context, req *domain.UpdateProductRequest)) *MockDB_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateProductRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateProduct_Call) Return(_a0 error) *MockDB_UpdateProduct_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
@@ -412,6 +3956,147 @@ func (_c *MockDB_UpdateProduct_Call) RunAndReturn(run func(context.Context, *dom
 	return _c
 }
 
+// UpdateSubCategory provides a mock function with given fields: ctx, req
+func (_m *MockDB) UpdateSubCategory(ctx context.Context, req *domain.UpdateSubCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateSubCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateSubCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubCategory'
+type MockDB_UpdateSubCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateSubCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateSubCategoryRequest
+func (_e *MockDB_Expecter) UpdateSubCategory(ctx interface{}, req interface{}) *MockDB_UpdateSubCategory_Call {
+	return &MockDB_UpdateSubCategory_Call{Call: _e.mock.On("UpdateSubCategory", ctx, req)}
+}
+
+func (_c *MockDB_UpdateSubCategory_Call) Run(run func(ctx context.Context, req *domain.UpdateSubCategoryRequest)) *MockDB_UpdateSubCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateSubCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateSubCategory_Call) Return(_a0 error) *MockDB_UpdateSubCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateSubCategory_Call) RunAndReturn(run func(context.Context, *domain.UpdateSubCategoryRequest) error) *MockDB_UpdateSubCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSupplier provides a mock function with given fields: ctx, req
+func (_m *MockDB) UpdateSupplier(ctx context.Context, req *domain.UpdateSupplierRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSupplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateSupplierRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSupplier'
+type MockDB_UpdateSupplier_Call struct {
+	*mock.Call
+}
+
+// UpdateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateSupplierRequest
+func (_e *MockDB_Expecter) UpdateSupplier(ctx interface{}, req interface{}) *MockDB_UpdateSupplier_Call {
+	return &MockDB_UpdateSupplier_Call{Call: _e.mock.On("UpdateSupplier", ctx, req)}
+}
+
+func (_c *MockDB_UpdateSupplier_Call) Run(run func(ctx context.Context, req *domain.UpdateSupplierRequest)) *MockDB_UpdateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateSupplier_Call) Return(_a0 error) *MockDB_UpdateSupplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateSupplier_Call) RunAndReturn(run func(context.Context, *domain.UpdateSupplierRequest) error) *MockDB_UpdateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateVariant provides a mock function with given fields: ctx, req
+func (_m *MockDB) UpdateVariant(ctx context.Context, req *domain.UpdateVariantRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateVariantRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateVariant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVariant'
+type MockDB_UpdateVariant_Call struct {
+	*mock.Call
+}
+
+// UpdateVariant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.UpdateVariantRequest
+func (_e *MockDB_Expecter) UpdateVariant(ctx interface{}, req interface{}) *MockDB_UpdateVariant_Call {
+	return &MockDB_UpdateVariant_Call{Call: _e.mock.On("UpdateVariant", ctx, req)}
+}
+
+func (_c *MockDB_UpdateVariant_Call) Run(run func(ctx context.Context, req *domain.UpdateVariantRequest)) *MockDB_UpdateVariant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.UpdateVariantRequest))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateVariant_Call) Return(_a0 error) *MockDB_UpdateVariant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateVariant_Call) RunAndReturn(run func(context.Context, *domain.UpdateVariantRequest) error) *MockDB_UpdateVariant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockDB creates a new instance of MockDB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDB(t interface {
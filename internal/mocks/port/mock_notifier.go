@@ -0,0 +1,84 @@
+// Code generated by mockery v2.38.0. DO NOT EDIT.
+
+package port
+
+import (
+	context "context"
+
+	domain "github.com/ebisaan/inventory/internal/application/core/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotifier is an autogenerated mock type for the Notifier type
+type MockNotifier struct {
+	mock.Mock
+}
+
+type MockNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotifier) EXPECT() *MockNotifier_Expecter {
+	return &MockNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyLowStock provides a mock function with given fields: ctx, alert
+func (_m *MockNotifier) NotifyLowStock(ctx context.Context, alert *domain.LowStockAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyLowStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.LowStockAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotifier_NotifyLowStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyLowStock'
+type MockNotifier_NotifyLowStock_Call struct {
+	*mock.Call
+}
+
+// NotifyLowStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert *domain.LowStockAlert
+func (_e *MockNotifier_Expecter) NotifyLowStock(ctx interface{}, alert interface{}) *MockNotifier_NotifyLowStock_Call {
+	return &MockNotifier_NotifyLowStock_Call{Call: _e.mock.On("NotifyLowStock", ctx, alert)}
+}
+
+func (_c *MockNotifier_NotifyLowStock_Call) Run(run func(ctx context.Context, alert *domain.LowStockAlert)) *MockNotifier_NotifyLowStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.LowStockAlert))
+	})
+	return _c
+}
+
+func (_c *MockNotifier_NotifyLowStock_Call) Return(_a0 error) *MockNotifier_NotifyLowStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotifier_NotifyLowStock_Call) RunAndReturn(run func(context.Context, *domain.LowStockAlert) error) *MockNotifier_NotifyLowStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotifier creates a new instance of MockNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotifier {
+	mock := &MockNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}